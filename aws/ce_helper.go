@@ -0,0 +1,374 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ceExpressionSchema returns the schema for a Cost Explorer Expression.
+// Expressions are recursive (and, or, not), so the nesting is limited to the specified level.
+func ceExpressionSchema(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     ceExpressionResource(level),
+	}
+}
+
+func ceExpressionsSchema(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     ceExpressionResource(level),
+	}
+}
+
+func ceExpressionResource(level int) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"cost_category": ceExpressionValuesSchema(validation.StringLenBetween(1, 50)),
+		"dimension":     ceExpressionValuesSchema(validation.StringInSlice(costexplorer.Dimension_Values(), false)),
+		"tags":          ceExpressionValuesSchema(validation.StringLenBetween(1, 1024)),
+	}
+
+	if level > 1 {
+		s["and"] = ceExpressionsSchema(level - 1)
+		s["not"] = ceExpressionSchema(level - 1)
+		s["or"] = ceExpressionsSchema(level - 1)
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+func ceExpressionValuesSchema(keyValidateFunc schema.SchemaValidateFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: keyValidateFunc,
+				},
+				"match_options": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+					},
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(0, 1024),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ceExpressionSchemaComputed returns the data source schema for a Cost Explorer Expression.
+func ceExpressionSchemaComputed(level int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     ceExpressionResourceComputed(level),
+	}
+}
+
+func ceExpressionResourceComputed(level int) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"cost_category": ceExpressionValuesSchemaComputed(),
+		"dimension":     ceExpressionValuesSchemaComputed(),
+		"tags":          ceExpressionValuesSchemaComputed(),
+	}
+
+	if level > 1 {
+		s["and"] = ceExpressionSchemaComputed(level - 1)
+		s["not"] = ceExpressionSchemaComputed(level - 1)
+		s["or"] = ceExpressionSchemaComputed(level - 1)
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+func ceExpressionValuesSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"match_options": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandCeExpression(tfMap map[string]interface{}) *costexplorer.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Expression{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 {
+		apiObject.And = expandCeExpressions(v)
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CostCategories = expandCeCostCategoryValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Dimensions = expandCeDimensionValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandCeExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Or = expandCeExpressions(v)
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Tags = expandCeTagValues(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCeExpressions(tfList []interface{}) []*costexplorer.Expression {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCeExpression(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCeCostCategoryValues(tfMap map[string]interface{}) *costexplorer.CostCategoryValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCeDimensionValues(tfMap map[string]interface{}) *costexplorer.DimensionValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.DimensionValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCeTagValues(tfMap map[string]interface{}) *costexplorer.TagValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.TagValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenCeExpression(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenCeExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenCeCostCategoryValues(v)}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenCeDimensionValues(v)}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenCeExpression(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = flattenCeExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{flattenCeTagValues(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCeExpressions(apiObjects []*costexplorer.Expression) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCeExpression(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCeCostCategoryValues(apiObject *costexplorer.CostCategoryValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeDimensionValues(apiObject *costexplorer.DimensionValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeTagValues(apiObject *costexplorer.TagValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+)
+
+const (
+	retryModeAdaptive = "adaptive"
+	retryModeStandard = "standard"
+)
+
+// RetryRateLimit is a client-side limit on the rate of requests to a service.
+type RetryRateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// serviceRetryHandlers mark additional errors as retryable for individual services,
+// keyed by AWS Go SDK service identifier.
+var serviceRetryHandlers = map[string]func(r *request.Request){
+	apigateway.ServiceID: func(r *request.Request) {
+		// Many operations can return an error such as:
+		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+		// Handle them all globally for the service client.
+		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	applicationautoscaling.ServiceID: func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress, thus we handle them
+	// here for the service client.
+	appconfig.ServiceID: func(r *request.Request) {
+		if r.Operation.Name == "StartDeployment" {
+			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	appsync.ServiceID: func(r *request.Request) {
+		if r.Operation.Name == "CreateGraphqlApi" {
+			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	cloudhsmv2.ServiceID: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	configservice.ServiceID: func(r *request.Request) {
+		// When calling Config Organization Rules API actions immediately
+		// after Organization creation, the API can randomly return the
+		// OrganizationAccessDeniedException error for a few minutes, even
+		// after succeeding a few requests.
+		switch r.Operation.Name {
+		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+					r.Retryable = aws.Bool(true)
+				}
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		}
+	},
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	dynamodb.ServiceID: func(r *request.Request) {
+		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+			return
+		}
+		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	ec2.ServiceID: func(r *request.Request) {
+		if r.Operation.Name == "CreateClientVpnEndpoint" {
+			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnConnection" {
+			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnGateway" {
+			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	fms.ServiceID: func(r *request.Request) {
+		// Acceptance testing creates and deletes resources in quick succession.
+		// The FMS onboarding process into Organizations is opaque to consumers.
+		// Since we cannot reasonably check this status before receiving the error,
+		// set the operation as retryable.
+		switch r.Operation.Name {
+		case "AssociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		case "DisassociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	kafka.ServiceID: func(r *request.Request) {
+		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	kinesis.ServiceID: func(r *request.Request) {
+		if r.Operation.Name == "CreateStream" {
+			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	organizations.ServiceID: func(r *request.Request) {
+		// Retry on the following error:
+		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	securityhub.ServiceID: func(r *request.Request) {
+		switch r.Operation.Name {
+		case "EnableOrganizationAdminAccount":
+			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	ssoadmin.ServiceID: func(r *request.Request) {
+		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	storagegateway.ServiceID: func(r *request.Request) {
+		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	wafv2.ServiceID: func(r *request.Request) {
+		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+}
+
+// configureRetry applies the retry mode, maximum backoff and rate limits to the
+// session along with the service specific retry handlers.
+func (c *Config) configureRetry(sess *session.Session) {
+	if c.RetryMaxBackoff > 0 {
+		sess.Config.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    c.MaxRetries,
+			MaxRetryDelay:    c.RetryMaxBackoff,
+			MaxThrottleDelay: c.RetryMaxBackoff,
+		}
+	}
+
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ServiceRetryHandler",
+		Fn: func(r *request.Request) {
+			if f, ok := serviceRetryHandlers[r.ClientInfo.ServiceID]; ok {
+				f(r)
+			}
+		},
+	})
+
+	limiters := make(map[string]ratelimit.Limiter)
+
+	for service, limit := range c.RetryRateLimits {
+		limiters[service] = ratelimit.NewTokenBucket(limit.RequestsPerSecond, limit.Burst)
+	}
+
+	if len(limiters) == 0 && c.RetryMode != retryModeAdaptive {
+		return
+	}
+
+	var adaptiveLimiters sync.Map
+
+	adaptiveLimiter := func(service string) *ratelimit.AdaptiveLimiter {
+		v, _ := adaptiveLimiters.LoadOrStore(service, ratelimit.NewAdaptiveLimiter())
+
+		return v.(*ratelimit.AdaptiveLimiter)
+	}
+
+	// Wait before signing so that each attempt, including retries, is limited.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			service := retryServiceName(r.ClientInfo)
+
+			if limiter, ok := limiters[service]; ok {
+				if err := limiter.Wait(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+					return
+				}
+			}
+
+			if c.RetryMode == retryModeAdaptive {
+				if err := adaptiveLimiter(service).Wait(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+					return
+				}
+			}
+		},
+	})
+
+	if c.RetryMode == retryModeAdaptive {
+		sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.AdaptiveRateLimitHandler",
+			Fn: func(r *request.Request) {
+				limiter := adaptiveLimiter(retryServiceName(r.ClientInfo))
+
+				if r.Error == nil {
+					limiter.Succeeded()
+				} else if r.IsErrorThrottle() {
+					limiter.Throttled()
+				}
+			},
+		})
+	}
+}
+
+// retryServiceName returns the name used to configure rate limits for a service,
+// which is its AWS Go SDK service identifier in lower case without spaces, e.g. "route53".
+func retryServiceName(info metadata.ClientInfo) string {
+	return strings.ToLower(strings.ReplaceAll(info.ServiceID, " ", ""))
+}
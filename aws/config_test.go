@@ -1,11 +1,27 @@
 package aws
 
 import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/route53"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -82,6 +98,239 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestConfigClient_AssumeRoleWithWebIdentity(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte(awsbase.MockWebIdentityToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		Config *Config
+	}{
+		{
+			Name: "web_identity_token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+		},
+		{
+			Name: "web_identity_token_file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			config := testCase.Config
+			config.Endpoints = map[string]string{"sts": ts.URL}
+			config.MaxRetries = 1
+			config.Region = "us-east-1" //lintignore:AWSAT003
+			config.SkipGetEC2Platforms = true
+			config.SkipMetadataApiCheck = true
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.accountid, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+
+			v, err := client.stsconn.Config.Credentials.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if got, expected := v.ProviderName, stscreds.WebIdentityProviderName; got != expected {
+				t.Errorf("got credentials provider %s, expected %s", got, expected)
+			}
+
+			if got, expected := v.AccessKeyID, awsbase.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureHTTPProxy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		HTTPProxy     string
+		ExpectedProxy string
+		ExpectedError bool
+	}{
+		{
+			Name: "no proxy",
+		},
+		{
+			Name:          "proxy",
+			HTTPProxy:     "http://proxy.example.com:3128",
+			ExpectedProxy: "http://proxy.example.com:3128",
+		},
+		{
+			Name:          "invalid proxy",
+			HTTPProxy:     "http://proxy.example.com:port",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				HTTPProxy: testCase.HTTPProxy,
+			}
+			client := cleanhttp.DefaultClient()
+
+			err := config.configureHTTPProxy(client)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedProxy == "" {
+				return
+			}
+
+			request, err := http.NewRequest(http.MethodGet, "https://sts.amazonaws.com", nil)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			proxyURL, err := client.Transport.(*http.Transport).Proxy(request)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := proxyURL.String(), testCase.ExpectedProxy; got != expected {
+				t.Errorf("got proxy %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestRetryServiceName(t *testing.T) {
+	testCases := []struct {
+		ServiceID string
+		Expected  string
+	}{
+		{
+			ServiceID: route53.ServiceID,
+			Expected:  "route53",
+		},
+		{
+			ServiceID: organizations.ServiceID,
+			Expected:  "organizations",
+		},
+		{
+			ServiceID: apigatewayv2.ServiceID,
+			Expected:  "apigatewayv2",
+		},
+		{
+			ServiceID: configservice.ServiceID,
+			Expected:  "configservice",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ServiceID, func(t *testing.T) {
+			if got := retryServiceName(metadata.ClientInfo{ServiceID: testCase.ServiceID}); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureRetry(t *testing.T) {
+	config := &Config{
+		MaxRetries:      5,
+		RetryMaxBackoff: 10 * time.Second,
+		RetryMode:       retryModeAdaptive,
+		RetryRateLimits: map[string]RetryRateLimit{
+			"route53": {Burst: 1, RequestsPerSecond: 5},
+		},
+	}
+	sess := session.Must(session.NewSession())
+
+	config.configureRetry(sess)
+
+	retryer, ok := sess.Config.Retryer.(client.DefaultRetryer)
+
+	if !ok {
+		t.Fatalf("got retryer %T, expected client.DefaultRetryer", sess.Config.Retryer)
+	}
+
+	if got, expected := retryer.MaxRetries(), config.MaxRetries; got != expected {
+		t.Errorf("got max retries %d, expected %d", got, expected)
+	}
+
+	if got, expected := retryer.MaxRetryDelay, config.RetryMaxBackoff; got != expected {
+		t.Errorf("got max retry delay %s, expected %s", got, expected)
+	}
+
+	for name, handlers := range map[string]request.HandlerList{
+		"Retry":           sess.Handlers.Retry,
+		"Sign":            sess.Handlers.Sign,
+		"CompleteAttempt": sess.Handlers.CompleteAttempt,
+	} {
+		if handlers.Len() == 0 {
+			t.Errorf("expected %s handlers", name)
+		}
+	}
+}
+
+// stashEnv clears the process environment, returning the previous values.
+func stashEnv() []string {
+	env := os.Environ()
+	os.Clearenv()
+	return env
+}
+
+// popEnv restores a process environment saved by stashEnv.
+func popEnv(env []string) {
+	os.Clearenv()
+
+	for _, e := range env {
+		p := strings.SplitN(e, "=", 2)
+		k, v := p[0], ""
+		if len(p) > 1 {
+			v = p[1]
+		}
+		os.Setenv(k, v)
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
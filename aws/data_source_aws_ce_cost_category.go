@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+)
+
+func dataSourceAwsCeCostCategory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsCeCostCategoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"cost_category_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"default_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dimension_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"rule": ceExpressionSchemaComputed(3),
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCeCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	arn := d.Get("cost_category_arn").(string)
+	costCategory, err := finder.CostCategoryByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Cost Category (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(costCategory.CostCategoryArn))
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+	d.Set("rule_version", costCategory.RuleVersion)
+
+	if err := d.Set("rule", flattenCeCostCategoryRules(costCategory.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	if err := d.Set("split_charge_rule", flattenCeCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return diag.Errorf("error setting split_charge_rule: %s", err)
+	}
+
+	return nil
+}
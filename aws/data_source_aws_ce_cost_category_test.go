@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsCeCostCategory_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+	dataSourceName := "data.aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsCeCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cost_category_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_value", resourceName, "default_value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "effective_start", resourceName, "effective_start"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.#", resourceName, "rule.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.value", resourceName, "rule.0.value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule.0.rule.0.dimension.0.key", resourceName, "rule.0.rule.0.dimension.0.key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rule_version", resourceName, "rule_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsCeCostCategoryConfig(rName string) string {
+	return composeConfig(testAccAWSCeCostCategoryConfigDefaultValue(rName, "other"), `
+data "aws_ce_cost_category" "test" {
+  cost_category_arn = aws_ce_cost_category.test.arn
+}
+`)
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectContactFlow() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectContactFlowRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_flow_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"contact_flow_id", "name"},
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"contact_flow_id", "name"},
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsConnectContactFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+	contactFlowID := d.Get("contact_flow_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		contactFlowSummary, err := finder.ContactFlowSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Contact Flow (%s): %s", name, err)
+		}
+
+		contactFlowID = aws.StringValue(contactFlowSummary.Id)
+	}
+
+	contactFlow, err := finder.ContactFlowByID(ctx, conn, instanceID, contactFlowID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Contact Flow (%s): %s", contactFlowID, err)
+	}
+
+	d.SetId(tfconnect.ContactFlowCreateResourceID(instanceID, aws.StringValue(contactFlow.Id)))
+
+	d.Set("arn", contactFlow.Arn)
+	d.Set("contact_flow_id", contactFlow.Id)
+	d.Set("content", contactFlow.Content)
+	d.Set("description", contactFlow.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", contactFlow.Name)
+	d.Set("type", contactFlow.Type)
+
+	if err := d.Set("tags", keyvaluetags.ConnectKeyValueTags(contactFlow.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
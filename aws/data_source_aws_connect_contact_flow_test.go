@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectContactFlow_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_contact_flow.test"
+	datasourceName := "data.aws_connect_contact_flow.test"
+	datasourceByNameName := "data.aws_connect_contact_flow.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectContactFlowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contact_flow_id", resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "content", resourceName, "content"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(datasourceByNameName, "contact_flow_id", resourceName, "contact_flow_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectContactFlowConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectContactFlowConfigTags1(rName, "key1", "value1"),
+		`
+data "aws_connect_contact_flow" "test" {
+  instance_id     = aws_connect_instance.test.id
+  contact_flow_id = aws_connect_contact_flow.test.contact_flow_id
+}
+
+data "aws_connect_contact_flow" "by_name" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_contact_flow.test.name
+}
+`)
+}
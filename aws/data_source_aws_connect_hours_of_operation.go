@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectHoursOfOperation() *schema.Resource {
+	timeSliceSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hours": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"minutes": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectHoursOfOperationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time":   timeSliceSchema(),
+						"start_time": timeSliceSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hours_of_operation_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"hours_of_operation_id", "name"},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"hours_of_operation_id", "name"},
+			},
+			"tags": tagsSchemaComputed(),
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsConnectHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+	hoursOfOperationID := d.Get("hours_of_operation_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		hoursOfOperationSummary, err := finder.HoursOfOperationSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Hours of Operation (%s): %s", name, err)
+		}
+
+		hoursOfOperationID = aws.StringValue(hoursOfOperationSummary.Id)
+	}
+
+	hoursOfOperation, err := finder.HoursOfOperationByID(ctx, conn, instanceID, hoursOfOperationID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Hours of Operation (%s): %s", hoursOfOperationID, err)
+	}
+
+	d.SetId(tfconnect.HoursOfOperationCreateResourceID(instanceID, aws.StringValue(hoursOfOperation.HoursOfOperationId)))
+
+	d.Set("arn", hoursOfOperation.HoursOfOperationArn)
+	if err := d.Set("config", flattenConnectHoursOfOperationConfigs(hoursOfOperation.Config)); err != nil {
+		return diag.Errorf("error setting config: %s", err)
+	}
+	d.Set("description", hoursOfOperation.Description)
+	d.Set("hours_of_operation_id", hoursOfOperation.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("name", hoursOfOperation.Name)
+	d.Set("time_zone", hoursOfOperation.TimeZone)
+
+	if err := d.Set("tags", keyvaluetags.ConnectKeyValueTags(hoursOfOperation.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectHoursOfOperation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_hours_of_operation.test"
+	datasourceName := "data.aws_connect_hours_of_operation.test"
+	datasourceByNameName := "data.aws_connect_hours_of_operation.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectHoursOfOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectHoursOfOperationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "config.#", resourceName, "config.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hours_of_operation_id", resourceName, "hours_of_operation_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceName, "time_zone", resourceName, "time_zone"),
+					resource.TestCheckResourceAttrPair(datasourceByNameName, "hours_of_operation_id", resourceName, "hours_of_operation_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectHoursOfOperationConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectHoursOfOperationConfigTags1(rName, "key1", "value1"),
+		`
+data "aws_connect_hours_of_operation" "test" {
+  instance_id           = aws_connect_instance.test.id
+  hours_of_operation_id = aws_connect_hours_of_operation.test.hours_of_operation_id
+}
+
+data "aws_connect_hours_of_operation" "by_name" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_hours_of_operation.test.name
+}
+`)
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_resolve_best_voices_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"contact_flow_logs_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"contact_lens_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"early_media_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"identity_management_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"instance_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_alias", "instance_id"},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"instance_alias", "instance_id"},
+			},
+			"outbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_custom_tts_voices_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsConnectInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID := d.Get("instance_id").(string)
+
+	if v, ok := d.GetOk("instance_alias"); ok {
+		alias := v.(string)
+		instanceSummary, err := finder.InstanceSummaryByAlias(ctx, conn, alias)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Instance (%s): %s", alias, err)
+		}
+
+		instanceID = aws.StringValue(instanceSummary.Id)
+	}
+
+	instance, err := finder.InstanceByID(ctx, conn, instanceID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Instance (%s): %s", instanceID, err)
+	}
+
+	d.SetId(aws.StringValue(instance.Id))
+
+	d.Set("arn", instance.Arn)
+	if instance.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(instance.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("identity_management_type", instance.IdentityManagementType)
+	d.Set("inbound_calls_enabled", instance.InboundCallsEnabled)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("instance_id", instance.Id)
+	d.Set("outbound_calls_enabled", instance.OutboundCallsEnabled)
+	d.Set("service_role", instance.ServiceRole)
+	d.Set("status", instance.InstanceStatus)
+
+	if aws.StringValue(instance.InstanceStatus) != connect.InstanceStatusActive {
+		return nil
+	}
+
+	for key, attributeType := range connectInstanceAttributeMap {
+		value, err := finder.InstanceAttribute(ctx, conn, d.Id(), attributeType)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Instance (%s) attribute (%s): %s", d.Id(), attributeType, err)
+		}
+
+		enabled, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return diag.Errorf("error parsing Connect Instance (%s) attribute (%s) value (%s): %s", d.Id(), attributeType, value, err)
+		}
+
+		d.Set(key, enabled)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectInstance_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_instance.test"
+	datasourceName := "data.aws_connect_instance.test"
+	datasourceByAliasName := "data.aws_connect_instance.by_alias"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "auto_resolve_best_voices_enabled", resourceName, "auto_resolve_best_voices_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contact_flow_logs_enabled", resourceName, "contact_flow_logs_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contact_lens_enabled", resourceName, "contact_lens_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "created_time", resourceName, "created_time"),
+					resource.TestCheckResourceAttrPair(datasourceName, "early_media_enabled", resourceName, "early_media_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "identity_management_type", resourceName, "identity_management_type"),
+					resource.TestCheckResourceAttrPair(datasourceName, "inbound_calls_enabled", resourceName, "inbound_calls_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_alias", resourceName, "instance_alias"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "outbound_calls_enabled", resourceName, "outbound_calls_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceName, "service_role", resourceName, "service_role"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "use_custom_tts_voices_enabled", resourceName, "use_custom_tts_voices_enabled"),
+					resource.TestCheckResourceAttrPair(datasourceByAliasName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceByAliasName, "instance_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectInstanceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		`
+data "aws_connect_instance" "test" {
+  instance_id = aws_connect_instance.test.id
+}
+
+data "aws_connect_instance" "by_alias" {
+  instance_alias = aws_connect_instance.test.instance_alias
+}
+`)
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectLambdaFunctionAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectLambdaFunctionAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func dataSourceAwsConnectLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID := d.Get("instance_id").(string)
+	functionARN := d.Get("function_arn").(string)
+	id := tfconnect.LambdaFunctionAssociationCreateResourceID(instanceID, functionARN)
+
+	_, err := finder.LambdaFunctionAssociationByARN(ctx, conn, instanceID, functionARN)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Lambda Function Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	d.Set("function_arn", functionARN)
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectLambdaFunctionAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_lambda_function_association.test"
+	datasourceName := "data.aws_connect_lambda_function_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectLambdaFunctionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectLambdaFunctionAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "function_arn", resourceName, "function_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectLambdaFunctionAssociationConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectLambdaFunctionAssociationConfig(rName),
+		`
+data "aws_connect_lambda_function_association" "test" {
+  function_arn = aws_connect_lambda_function_association.test.function_arn
+  instance_id  = aws_connect_lambda_function_association.test.instance_id
+}
+`)
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectQueue() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectQueueRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hours_of_operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"max_contacts": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "queue_id"},
+			},
+			"outbound_caller_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"outbound_caller_id_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outbound_caller_id_number_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outbound_flow_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "queue_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsConnectQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		queueSummary, err := finder.QueueSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Queue (%s): %s", name, err)
+		}
+
+		queueID = aws.StringValue(queueSummary.Id)
+	}
+
+	queue, err := finder.QueueByID(ctx, conn, instanceID, queueID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Queue (%s): %s", queueID, err)
+	}
+
+	d.SetId(tfconnect.QueueCreateResourceID(instanceID, aws.StringValue(queue.QueueId)))
+
+	d.Set("arn", queue.QueueArn)
+	d.Set("description", queue.Description)
+	d.Set("hours_of_operation_id", queue.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("max_contacts", queue.MaxContacts)
+	d.Set("name", queue.Name)
+	if v := flattenConnectOutboundCallerConfig(queue.OutboundCallerConfig); len(v) > 0 {
+		if err := d.Set("outbound_caller_config", []interface{}{v}); err != nil {
+			return diag.Errorf("error setting outbound_caller_config: %s", err)
+		}
+	} else {
+		d.Set("outbound_caller_config", nil)
+	}
+	d.Set("queue_id", queue.QueueId)
+	d.Set("status", queue.Status)
+
+	if err := d.Set("tags", keyvaluetags.ConnectKeyValueTags(queue.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectQueue_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_queue.test"
+	datasourceName := "data.aws_connect_queue.test"
+	datasourceByNameName := "data.aws_connect_queue.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectQueueConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hours_of_operation_id", resourceName, "hours_of_operation_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "max_contacts", resourceName, "max_contacts"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "outbound_caller_config.#", resourceName, "outbound_caller_config.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "queue_id", resourceName, "queue_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceByNameName, "queue_id", resourceName, "queue_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectQueueConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectQueueConfigTags1(rName, "key1", "value1"),
+		`
+data "aws_connect_queue" "test" {
+  instance_id = aws_connect_instance.test.id
+  queue_id    = aws_connect_queue.test.queue_id
+}
+
+data "aws_connect_queue" "by_name" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_queue.test.name
+}
+`)
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectRoutingProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectRoutingProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_outbound_queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"media_concurrencies": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"concurrency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "routing_profile_id"},
+			},
+			"queue_configs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"queue_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "routing_profile_id"},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsConnectRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+	routingProfileID := d.Get("routing_profile_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		routingProfileSummary, err := finder.RoutingProfileSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Routing Profile (%s): %s", name, err)
+		}
+
+		routingProfileID = aws.StringValue(routingProfileSummary.Id)
+	}
+
+	routingProfile, err := finder.RoutingProfileByID(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Routing Profile (%s): %s", routingProfileID, err)
+	}
+
+	queueConfigs, err := finder.RoutingProfileQueueConfigsByID(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Routing Profile (%s) queues: %s", routingProfileID, err)
+	}
+
+	d.SetId(tfconnect.RoutingProfileCreateResourceID(instanceID, aws.StringValue(routingProfile.RoutingProfileId)))
+
+	d.Set("arn", routingProfile.RoutingProfileArn)
+	d.Set("default_outbound_queue_id", routingProfile.DefaultOutboundQueueId)
+	d.Set("description", routingProfile.Description)
+	d.Set("instance_id", instanceID)
+	if err := d.Set("media_concurrencies", flattenConnectMediaConcurrencies(routingProfile.MediaConcurrencies)); err != nil {
+		return diag.Errorf("error setting media_concurrencies: %s", err)
+	}
+	d.Set("name", routingProfile.Name)
+	if err := d.Set("queue_configs", flattenConnectRoutingProfileQueueConfigSummaries(queueConfigs)); err != nil {
+		return diag.Errorf("error setting queue_configs: %s", err)
+	}
+	d.Set("routing_profile_id", routingProfile.RoutingProfileId)
+
+	if err := d.Set("tags", keyvaluetags.ConnectKeyValueTags(routingProfile.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectRoutingProfile_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_connect_routing_profile.test"
+	datasourceName := "data.aws_connect_routing_profile.test"
+	datasourceByNameName := "data.aws_connect_routing_profile.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectRoutingProfileConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "default_outbound_queue_id", resourceName, "default_outbound_queue_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "media_concurrencies.#", resourceName, "media_concurrencies.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "queue_configs.#", resourceName, "queue_configs.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "routing_profile_id", resourceName, "routing_profile_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceByNameName, "routing_profile_id", resourceName, "routing_profile_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectRoutingProfileConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectRoutingProfileConfig(rName, "Test", 1),
+		`
+data "aws_connect_routing_profile" "test" {
+  instance_id        = aws_connect_instance.test.id
+  routing_profile_id = aws_connect_routing_profile.test.routing_profile_id
+}
+
+data "aws_connect_routing_profile" "by_name" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_routing_profile.test.name
+}
+`)
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func dataSourceAwsConnectSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsConnectSecurityProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "security_profile_id"},
+			},
+			"security_profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "security_profile_id"},
+			},
+		},
+	}
+}
+
+func dataSourceAwsConnectSecurityProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID := d.Get("instance_id").(string)
+
+	var securityProfile *connect.SecurityProfileSummary
+	var err error
+
+	if v, ok := d.GetOk("security_profile_id"); ok {
+		securityProfile, err = finder.SecurityProfileSummaryByID(ctx, conn, instanceID, v.(string))
+	} else {
+		securityProfile, err = finder.SecurityProfileSummaryByName(ctx, conn, instanceID, d.Get("name").(string))
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Security Profile: %s", err)
+	}
+
+	d.SetId(tfconnect.SecurityProfileCreateResourceID(instanceID, aws.StringValue(securityProfile.Id)))
+
+	d.Set("arn", securityProfile.Arn)
+	d.Set("instance_id", instanceID)
+	d.Set("name", securityProfile.Name)
+	d.Set("security_profile_id", securityProfile.Id)
+
+	return nil
+}
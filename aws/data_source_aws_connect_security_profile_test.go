@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSConnectSecurityProfile_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	datasourceName := "data.aws_connect_security_profile.test"
+	datasourceByIDName := "data.aws_connect_security_profile.by_id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSConnectSecurityProfileConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMatchResourceAttrRegionalARN(datasourceName, "arn", "connect", regexp.MustCompile(`instance/.+/security-profile/.+`)),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", "Admin"),
+					resource.TestCheckResourceAttrSet(datasourceName, "security_profile_id"),
+					resource.TestCheckResourceAttrPair(datasourceByIDName, "arn", datasourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceByIDName, "name", datasourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSConnectSecurityProfileConfig(rName string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		`
+data "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = "Admin"
+}
+
+data "aws_connect_security_profile" "by_id" {
+  instance_id         = aws_connect_instance.test.id
+  security_profile_id = data.aws_connect_security_profile.test.security_profile_id
+}
+`)
+}
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/finder"
+)
+
+func dataSourceAwsEMRContainersVirtualCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsEMRContainersVirtualClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_provider": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eks_info": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespace": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"virtual_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEMRContainersVirtualClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).emrcontainersconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	id := d.Get("virtual_cluster_id").(string)
+
+	vc, err := finder.VirtualClusterByID(ctx, conn, id)
+
+	if err != nil {
+		return diag.Errorf("error reading EMR containers virtual cluster (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(vc.Id))
+
+	d.Set("arn", vc.Arn)
+	if vc.ContainerProvider != nil {
+		if err := d.Set("container_provider", []interface{}{flattenEMRContainersContainerProvider(vc.ContainerProvider)}); err != nil {
+			return diag.Errorf("error setting container_provider: %s", err)
+		}
+	} else {
+		d.Set("container_provider", nil)
+	}
+	d.Set("created_at", aws.TimeValue(vc.CreatedAt).Format(time.RFC3339))
+	d.Set("name", vc.Name)
+	d.Set("state", vc.State)
+	d.Set("virtual_cluster_id", vc.Id)
+
+	if err := d.Set("tags", keyvaluetags.EmrcontainersKeyValueTags(vc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+func TestAccDataSourceAWSEMRContainersVirtualCluster_basic(t *testing.T) {
+	eksClusterName := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksClusterName, EnvVarEMRContainersEksClusterNameMessageError)
+	namespace := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksNamespace, EnvVarEMRContainersEksNamespaceMessageError)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	dataSourceName := "data.aws_emrcontainers_virtual_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSEMRContainersVirtualClusterConfig(rName, eksClusterName, namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.#", resourceName, "container_provider.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.id", resourceName, "container_provider.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.info.0.eks_info.0.namespace", resourceName, "container_provider.0.info.0.eks_info.0.namespace"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.type", resourceName, "container_provider.0.type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", emrcontainers.VirtualClusterStateRunning),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_cluster_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSEMRContainersVirtualClusterConfig(rName, eksClusterName, namespace string) string {
+	return composeConfig(
+		testAccAWSEMRContainersVirtualClusterConfigBasic(rName, eksClusterName, namespace),
+		`
+data "aws_emrcontainers_virtual_cluster" "test" {
+  virtual_cluster_id = aws_emrcontainers_virtual_cluster.test.id
+}
+`)
+}
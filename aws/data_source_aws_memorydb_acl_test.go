@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSMemoryDbAcl_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+	dataSourceName := "data.aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, memorydb.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSMemoryDbAclConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "minimum_engine_version", resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_names.#", resourceName, "user_names.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Test", resourceName, "tags.Test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSMemoryDbAclConfig(rName string) string {
+	return composeConfig(
+		testAccAWSMemoryDbAclConfig(rName, []string{rName}, []string{rName}),
+		`
+data "aws_memorydb_acl" "test" {
+  name = aws_memorydb_acl.test.name
+}
+`,
+	)
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+)
+
+func dataSourceAwsMemoryDbParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsMemoryDbParameterGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: memoryDbParameterHash,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsMemoryDbParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+
+	group, err := finder.ParameterGroupByName(ctx, conn, name)
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(group.Name))
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+
+	parameters, err := finder.ParametersByParameterGroupName(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing parameters for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	allParameters := make(map[string]struct{}, len(parameters))
+
+	for _, parameter := range parameters {
+		allParameters[strings.ToLower(aws.StringValue(parameter.Name))] = struct{}{}
+	}
+
+	if err := d.Set("parameter", flattenMemoryDbParameters(parameters, allParameters)); err != nil {
+		return diag.Errorf("error setting parameter: %s", err)
+	}
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSMemoryDbParameterGroup_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+	dataSourceName := "data.aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, memorydb.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSMemoryDbParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "family", resourceName, "family"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Test", resourceName, "tags.Test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSMemoryDbParameterGroupConfig(rName string) string {
+	return composeConfig(
+		testAccAWSMemoryDbParameterGroupConfig(rName),
+		`
+data "aws_memorydb_parameter_group" "test" {
+  name = aws_memorydb_parameter_group.test.name
+}
+`,
+	)
+}
@@ -1,11 +1,17 @@
 package aws
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/lister"
 )
 
 const keyRequestPageSize = 1000
@@ -59,6 +65,42 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"objects_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -104,8 +146,9 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 	var commonPrefixes []string
 	var keys []string
 	var owners []string
+	var objects []interface{}
 
-	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+	err := lister.ListObjectsV2Pages(conn, &listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
 			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
 		}
@@ -116,10 +159,16 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 			if object.Owner != nil {
 				owners = append(owners, aws.StringValue(object.Owner.ID))
 			}
+
+			objects = append(objects, flattenS3Object(object))
 		}
 
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
+		if maxKeys <= 0 {
+			return false
+		}
+
 		if maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
@@ -145,5 +194,51 @@ func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting owners: %w", err)
 	}
 
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("error setting objects: %w", err)
+	}
+
+	d.Set("objects_hash", s3ObjectsHash(objects))
+
 	return nil
 }
+
+func flattenS3Object(apiObject *s3.Object) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"etag":          strings.Trim(aws.StringValue(apiObject.ETag), `"`),
+		"key":           aws.StringValue(apiObject.Key),
+		"size":          int(aws.Int64Value(apiObject.Size)),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.StringValue(v.ID)
+	}
+
+	return tfMap
+}
+
+// s3ObjectsHash returns a digest of the listed object keys and ETags.
+// It changes whenever an object is added, removed or overwritten.
+func s3ObjectsHash(objects []interface{}) string {
+	lines := make([]string, 0, len(objects))
+
+	for _, object := range objects {
+		tfMap := object.(map[string]interface{})
+		lines = append(lines, fmt.Sprintf("%s\t%s", tfMap["key"], tfMap["etag"]))
+	}
+
+	sort.Strings(lines)
+
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
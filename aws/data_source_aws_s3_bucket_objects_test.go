@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -175,6 +176,39 @@ func TestAccDataSourceAWSS3BucketObjects_maxKeys(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSS3BucketObjects_objects(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_s3_bucket_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ErrorCheck:                testAccErrorCheck(t, s3.EndpointsID),
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigObjects(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "13"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", s3.ObjectStorageClassStandard),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.etag", "aws_s3_bucket_object.object3", "etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.size", "19"),
+					resource.TestMatchResourceAttr(dataSourceName, "objects_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceAWSS3BucketObjects_startAfter(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -378,6 +412,17 @@ data "aws_s3_bucket_objects" "yesh" {
 `, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
 }
 
+func testAccAWSDataSourceS3ObjectsConfigObjects(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket = aws_s3_bucket.objects_bucket.id
+  prefix = "arch/navajo/"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
 func testAccAWSDataSourceS3ObjectsConfigStartAfter(randInt int) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+)
+
+func dataSourceAwsServiceCatalogAppRegistryApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsServiceCatalogAppRegistryApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsServiceCatalogAppRegistryApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	// The API accepts either the application ID or name.
+	id := d.Get("id").(string)
+	if v, ok := d.GetOk("name"); ok {
+		id = v.(string)
+	}
+
+	application, err := finder.ApplicationByID(ctx, conn, id)
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Application (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(application.Id))
+
+	d.Set("arn", application.Arn)
+	d.Set("creation_time", aws.TimeValue(application.CreationTime).Format(time.RFC3339))
+	d.Set("description", application.Description)
+	d.Set("last_update_time", aws.TimeValue(application.LastUpdateTime).Format(time.RFC3339))
+	d.Set("name", application.Name)
+
+	if err := d.Set("tags", keyvaluetags.AppregistryKeyValueTags(application.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+)
+
+func dataSourceAwsServiceCatalogAppRegistryAttributeGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsServiceCatalogAppRegistryAttributeGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsServiceCatalogAppRegistryAttributeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	// The API accepts either the attribute group ID or name.
+	id := d.Get("id").(string)
+	if v, ok := d.GetOk("name"); ok {
+		id = v.(string)
+	}
+
+	attributeGroup, err := finder.AttributeGroupByID(ctx, conn, id)
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Attribute Group (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(attributeGroup.Id))
+
+	d.Set("arn", attributeGroup.Arn)
+	d.Set("attributes", attributeGroup.Attributes)
+	d.Set("creation_time", aws.TimeValue(attributeGroup.CreationTime).Format(time.RFC3339))
+	d.Set("description", attributeGroup.Description)
+	d.Set("last_update_time", aws.TimeValue(attributeGroup.LastUpdateTime).Format(time.RFC3339))
+	d.Set("name", attributeGroup.Name)
+
+	if err := d.Set("tags", keyvaluetags.AppregistryKeyValueTags(attributeGroup.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
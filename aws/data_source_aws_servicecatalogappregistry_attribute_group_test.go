@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAWSServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	dataSourceByIDName := "data.aws_servicecatalogappregistry_attribute_group.by_id"
+	dataSourceByNameName := "data.aws_servicecatalogappregistry_attribute_group.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSServiceCatalogAppRegistryAttributeGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "attributes", resourceName, "attributes"),
+					resource.TestCheckResourceAttrSet(dataSourceByIDName, "creation_time"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceByIDName, "last_update_time"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "tags.Name", resourceName, "tags.Name"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSServiceCatalogAppRegistryAttributeGroupConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name        = %[1]q
+  description = "test"
+
+  attributes = jsonencode({
+    app = "exampleapp"
+  })
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_servicecatalogappregistry_attribute_group" "by_id" {
+  id = aws_servicecatalogappregistry_attribute_group.test.id
+}
+
+data "aws_servicecatalogappregistry_attribute_group" "by_name" {
+  name = aws_servicecatalogappregistry_attribute_group.test.name
+}
+`, rName)
+}
@@ -0,0 +1,140 @@
+package apitrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// Record is the trace of a single AWS API request, including any retries.
+type Record struct {
+	Time       time.Time `json:"time"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	Region     string    `json:"region,omitempty"`
+	LatencyMS  int64     `json:"latency_ms"`
+	RetryCount int       `json:"retry_count"`
+	Throttled  bool      `json:"throttled"`
+	StatusCode int       `json:"status_code,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	ErrorCode  string    `json:"error_code,omitempty"`
+}
+
+// Tracer writes one JSON Record per line for each completed AWS API request.
+type Tracer struct {
+	lock      sync.Mutex
+	w         io.Writer
+	throttled sync.Map
+}
+
+// New returns a Tracer writing to w.
+func New(w io.Writer) *Tracer {
+	return &Tracer{
+		w: w,
+	}
+}
+
+var (
+	fileTracersLock sync.Mutex
+	fileTracers     = make(map[string]*Tracer)
+)
+
+// OpenFile returns a Tracer appending to the named file, creating it if necessary.
+// Tracers are shared by all provider configurations writing to the same file.
+func OpenFile(filename string) (*Tracer, error) {
+	filename, err := homedir.Expand(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding API trace filename: %w", err)
+	}
+
+	fileTracersLock.Lock()
+	defer fileTracersLock.Unlock()
+
+	if tracer, ok := fileTracers[filename]; ok {
+		return tracer, nil
+	}
+
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API trace file (%s): %w", filename, err)
+	}
+
+	tracer := New(f)
+	fileTracers[filename] = tracer
+
+	return tracer, nil
+}
+
+// AddHandlers adds the request handlers that trace AWS API requests.
+func (t *Tracer) AddHandlers(handlers *request.Handlers) {
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APITraceAttemptHandler",
+		Fn:   t.completeAttempt,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APITraceHandler",
+		Fn:   t.complete,
+	})
+}
+
+// completeAttempt records whether any attempt of the request was throttled.
+func (t *Tracer) completeAttempt(r *request.Request) {
+	if r.IsErrorThrottle() {
+		t.throttled.Store(r, true)
+	}
+}
+
+func (t *Tracer) complete(r *request.Request) {
+	_, throttled := t.throttled.LoadAndDelete(r)
+
+	record := Record{
+		Time:       r.Time.UTC(),
+		Service:    r.ClientInfo.ServiceID,
+		Region:     aws.StringValue(r.Config.Region),
+		LatencyMS:  time.Since(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+		Throttled:  throttled,
+		RequestID:  r.RequestID,
+	}
+
+	if r.Operation != nil {
+		record.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		record.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		record.ErrorCode = err.Code()
+	}
+
+	t.write(record)
+}
+
+func (t *Tracer) write(record Record) {
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to marshal API trace record: %s", err)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, err := t.w.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Unable to write API trace record: %s", err)
+	}
+}
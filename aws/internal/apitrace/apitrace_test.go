@@ -0,0 +1,89 @@
+package apitrace_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+)
+
+func TestTracer(t *testing.T) {
+	var buf bytes.Buffer
+
+	handlers := request.Handlers{}
+	handlers.Send.PushBack(func(r *request.Request) {
+		if r.RetryCount == 0 {
+			r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+			r.Error = awserr.New("Throttling", "Rate exceeded", nil)
+			return
+		}
+
+		r.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+		r.RequestID = "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE"
+	})
+	handlers.AfterRetry.PushBack(func(r *request.Request) {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+
+		if aws.BoolValue(r.Retryable) {
+			r.RetryCount++
+			r.Error = nil
+		}
+	})
+
+	apitrace.New(&buf).AddHandlers(&handlers)
+
+	r := request.New(
+		aws.Config{Region: aws.String("us-west-2")}, //lintignore:AWSAT003
+		metadata.ClientInfo{ServiceID: "Route 53", Endpoint: "https://route53.amazonaws.com"},
+		handlers,
+		client.DefaultRetryer{NumMaxRetries: 1},
+		&request.Operation{Name: "ListHostedZones", HTTPMethod: http.MethodGet, HTTPPath: "/"},
+		nil,
+		nil,
+	)
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var record apitrace.Record
+
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("error unmarshaling trace record (%s): %s", buf.String(), err)
+	}
+
+	if got, expected := record.Service, "Route 53"; got != expected {
+		t.Errorf("got service %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.Operation, "ListHostedZones"; got != expected {
+		t.Errorf("got operation %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.RetryCount, 1; got != expected {
+		t.Errorf("got retry count %d, expected %d", got, expected)
+	}
+
+	if !record.Throttled {
+		t.Error("expected throttled request")
+	}
+
+	if got, expected := record.StatusCode, http.StatusOK; got != expected {
+		t.Errorf("got status code %d, expected %d", got, expected)
+	}
+
+	if got, expected := record.RequestID, "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE"; got != expected {
+		t.Errorf("got request ID %s, expected %s", got, expected)
+	}
+
+	if record.ErrorCode != "" {
+		t.Errorf("got error code %s, expected none", record.ErrorCode)
+	}
+}
@@ -0,0 +1,2 @@
+// apitrace contains a structured trace of AWS API requests for debugging.
+package apitrace
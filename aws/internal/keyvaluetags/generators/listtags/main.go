@@ -24,6 +24,7 @@ var serviceNames = []string{
 	"apigatewayv2",
 	"appconfig",
 	"appmesh",
+	"appregistry",
 	"apprunner",
 	"appstream",
 	"appsync",
@@ -47,10 +48,12 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
@@ -67,6 +70,7 @@ var serviceNames = []string{
 	"elasticsearchservice",
 	"elb",
 	"elbv2",
+	"emrcontainers",
 	"firehose",
 	"fsx",
 	"gamelift",
@@ -93,6 +97,7 @@ var serviceNames = []string{
 	"medialive",
 	"mediapackage",
 	"mediastore",
+	"memorydb",
 	"mq",
 	"neptune",
 	"networkfirewall",
@@ -105,6 +110,8 @@ var serviceNames = []string{
 	"rds",
 	"resourcegroups",
 	"route53",
+	"route53domains",
+	"route53recoveryreadiness",
 	"route53resolver",
 	"sagemaker",
 	"securityhub",
@@ -116,6 +123,8 @@ var serviceNames = []string{
 	"sns",
 	"sqs",
 	"ssm",
+	"ssmcontacts",
+	"ssmincidents",
 	"ssoadmin",
 	"storagegateway",
 	"swf",
@@ -73,6 +73,7 @@ var sliceServiceNames = []string{
 	"licensemanager",
 	"lightsail",
 	"mediastore",
+	"memorydb",
 	"neptune",
 	"networkfirewall",
 	"networkmanager",
@@ -83,6 +84,7 @@ var sliceServiceNames = []string{
 	"redshift",
 	"resourcegroupstaggingapi",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"s3",
 	"s3control",
@@ -95,6 +97,7 @@ var sliceServiceNames = []string{
 	"shield",
 	"sns",
 	"ssm",
+	"ssmcontacts",
 	"ssoadmin",
 	"storagegateway",
 	"swf",
@@ -113,6 +116,7 @@ var mapServiceNames = []string{
 	"apigateway",
 	"apigatewayv2",
 	"appconfig",
+	"appregistry",
 	"appstream",
 	"appsync",
 	"backup",
@@ -122,9 +126,12 @@ var mapServiceNames = []string{
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
+	"connect",
 	"dataexchange",
+	"detective",
 	"dlm",
 	"eks",
+	"emrcontainers",
 	"glacier",
 	"glue",
 	"guardduty",
@@ -133,6 +140,7 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"locationservice",
 	"macie2",
 	"mediaconnect",
 	"mediaconvert",
@@ -144,10 +152,12 @@ var mapServiceNames = []string{
 	"qldb",
 	"pinpoint",
 	"resourcegroups",
+	"route53recoveryreadiness",
 	"securityhub",
 	"schemas",
 	"signer",
 	"sqs",
+	"ssmincidents",
 	"synthetics",
 	"worklink",
 }
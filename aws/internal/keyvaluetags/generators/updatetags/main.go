@@ -25,6 +25,7 @@ var serviceNames = []string{
 	"apigatewayv2",
 	"appconfig",
 	"appmesh",
+	"appregistry",
 	"apprunner",
 	"appstream",
 	"appsync",
@@ -48,11 +49,13 @@ var serviceNames = []string{
 	"cognitoidentity",
 	"cognitoidentityprovider",
 	"configservice",
+	"connect",
 	"databasemigrationservice",
 	"dataexchange",
 	"datapipeline",
 	"datasync",
 	"dax",
+	"detective",
 	"devicefarm",
 	"directconnect",
 	"directoryservice",
@@ -70,6 +73,7 @@ var serviceNames = []string{
 	"elb",
 	"elbv2",
 	"emr",
+	"emrcontainers",
 	"firehose",
 	"fsx",
 	"gamelift",
@@ -91,11 +95,13 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"locationservice",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
 	"mediapackage",
 	"mediastore",
+	"memorydb",
 	"mq",
 	"mwaa",
 	"neptune",
@@ -111,6 +117,8 @@ var serviceNames = []string{
 	"redshift",
 	"resourcegroups",
 	"route53",
+	"route53domains",
+	"route53recoveryreadiness",
 	"route53resolver",
 	"sagemaker",
 	"secretsmanager",
@@ -123,6 +131,8 @@ var serviceNames = []string{
 	"sns",
 	"sqs",
 	"ssm",
+	"ssmcontacts",
+	"ssmincidents",
 	"ssoadmin",
 	"storagegateway",
 	"swf",
@@ -17,6 +17,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 )
 
+const (
+	RequiredTagsEnforcementError = "error"
+	RequiredTagsEnforcementWarn  = "warn"
+)
+
 const (
 	AwsTagKeyPrefix                             = `aws:`
 	ElasticbeanstalkTagKeyPrefix                = `elasticbeanstalk:`
@@ -32,8 +37,20 @@ type DefaultConfig struct {
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys         KeyValueTags
+	KeyPrefixes  KeyValueTags
+	KeyRegexes   []*regexp.Regexp
+	ValueRegexes []*regexp.Regexp
+}
+
+// RequiredConfig contains tags required across all resources.
+type RequiredConfig struct {
+	// Enforcement is either RequiredTagsEnforcementError or RequiredTagsEnforcementWarn.
+	Enforcement          string
+	ExcludeResourceTypes []string
+	// Tags maps each required tag key to the regular expressions, any of which
+	// the tag value must match. An empty list allows any value.
+	Tags map[string][]*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -75,6 +92,81 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// Excluded returns true if the given resource type is excluded from the configuration.
+func (rc *RequiredConfig) Excluded(resourceType string) bool {
+	if rc == nil {
+		return true
+	}
+
+	for _, excludedResourceType := range rc.ExcludeResourceTypes {
+		if excludedResourceType == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error if any required tag is missing or has a value
+// not matching any of its allowed value regular expressions.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var problems []string
+
+	for _, key := range rc.keys() {
+		if !tags.KeyExists(key) {
+			problems = append(problems, fmt.Sprintf("missing required tag %q", key))
+			continue
+		}
+
+		regexps := rc.Tags[key]
+
+		if len(regexps) == 0 {
+			continue
+		}
+
+		value := ""
+
+		if tagData := tags.KeyTagData(key); tagData != nil && tagData.Value != nil {
+			value = *tagData.Value
+		}
+
+		matched := false
+
+		for _, re := range regexps {
+			if re.MatchString(value) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			problems = append(problems, fmt.Sprintf("tag %q value %q does not match any allowed value", key, value))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("required tags: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func (rc *RequiredConfig) keys() []string {
+	keys := make([]string, 0, len(rc.Tags))
+
+	for k := range rc.Tags {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -102,6 +194,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreValueRegexes(config.ValueRegexes)
 
 	return result
 }
@@ -153,6 +247,56 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyRegexes returns non-matching tag keys.
+func (tags KeyValueTags) IgnoreKeyRegexes(ignoreKeyRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreKeyRegex := range ignoreKeyRegexes {
+			if ignoreKeyRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValueRegexes returns tags with non-matching values.
+func (tags KeyValueTags) IgnoreValueRegexes(ignoreValueRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		if v != nil && v.Value != nil {
+			for _, ignoreValueRegex := range ignoreValueRegexes {
+				if ignoreValueRegex.MatchString(*v.Value) {
+					ignore = true
+					break
+				}
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
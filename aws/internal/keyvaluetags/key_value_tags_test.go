@@ -1,6 +1,7 @@
 package keyvaluetags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -291,6 +292,130 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigExcluded(t *testing.T) {
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		want           bool
+	}{
+		{
+			name:         "nil config",
+			resourceType: "aws_vpc",
+			want:         true,
+		},
+		{
+			name:           "no exclusions",
+			requiredConfig: &RequiredConfig{},
+			resourceType:   "aws_vpc",
+			want:           false,
+		},
+		{
+			name: "excluded",
+			requiredConfig: &RequiredConfig{
+				ExcludeResourceTypes: []string{"aws_subnet", "aws_vpc"},
+			},
+			resourceType: "aws_vpc",
+			want:         true,
+		},
+		{
+			name: "not excluded",
+			requiredConfig: &RequiredConfig{
+				ExcludeResourceTypes: []string{"aws_subnet"},
+			},
+			resourceType: "aws_vpc",
+			want:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.Excluded(testCase.resourceType)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		Tags: map[string][]*regexp.Regexp{
+			"CostCenter": {regexp.MustCompile(`^\d{4}$`)},
+			"DataClassification": {
+				regexp.MustCompile(`^public$`),
+				regexp.MustCompile(`^confidential$`),
+			},
+			"Owner": nil,
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		wantErr        bool
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{}),
+		},
+		{
+			name:           "all valid",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":         "1234",
+				"DataClassification": "confidential",
+				"Owner":              "platform",
+				"Other":              "value",
+			}),
+		},
+		{
+			name:           "missing key",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":         "1234",
+				"DataClassification": "public",
+			}),
+			wantErr: true,
+		},
+		{
+			name:           "invalid value",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":         "12345",
+				"DataClassification": "public",
+				"Owner":              "platform",
+			}),
+			wantErr: true,
+		},
+		{
+			name:           "no value regexps",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":         "1234",
+				"DataClassification": "public",
+				"Owner":              "",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.tags)
+
+			if err != nil && !testCase.wantErr {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.wantErr {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -533,6 +658,63 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"aws-cdk:path":               "stack/resource",
+				"kubernetes.io/cluster/test": "owned",
+				"kubernetes.io/role/elb":     "1",
+				"key1":                       "value1",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`^aws-cdk:`),
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/role/elb": "1",
+				"key1":                   "value1",
+			},
+		},
+		{
+			name: "value regexes some matching",
+			tags: New(map[string]string{
+				"key1": "managed-by-external",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValueRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^managed-by-`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys and regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`2$`),
+				},
+				ValueRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^value3$`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
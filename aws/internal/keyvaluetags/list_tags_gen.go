@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appregistry"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/aws/aws-sdk-go/service/appsync"
@@ -34,10 +35,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -54,6 +57,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
@@ -80,6 +84,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
@@ -92,6 +97,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/schemas"
@@ -103,6 +110,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
@@ -235,6 +244,23 @@ func AppmeshListTags(conn *appmesh.AppMesh, identifier string) (KeyValueTags, er
 	return AppmeshKeyValueTags(output.Tags), nil
 }
 
+// AppregistryListTags lists appregistry service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AppregistryListTags(conn *appregistry.AppRegistry, identifier string) (KeyValueTags, error) {
+	input := &appregistry.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return AppregistryKeyValueTags(output.Tags), nil
+}
+
 // ApprunnerListTags lists apprunner service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -631,6 +657,23 @@ func ConfigserviceListTags(conn *configservice.ConfigService, identifier string)
 	return ConfigserviceKeyValueTags(output.Tags), nil
 }
 
+// ConnectListTags lists connect service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ConnectListTags(conn *connect.Connect, identifier string) (KeyValueTags, error) {
+	input := &connect.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return ConnectKeyValueTags(output.Tags), nil
+}
+
 // DatabasemigrationserviceListTags lists databasemigrationservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -699,6 +742,23 @@ func DaxListTags(conn *dax.DAX, identifier string) (KeyValueTags, error) {
 	return DaxKeyValueTags(output.Tags), nil
 }
 
+// DetectiveListTags lists detective service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DetectiveListTags(conn *detective.Detective, identifier string) (KeyValueTags, error) {
+	input := &detective.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return DetectiveKeyValueTags(output.Tags), nil
+}
+
 // DevicefarmListTags lists devicefarm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -976,6 +1036,23 @@ func Elbv2ListTags(conn *elbv2.ELBV2, identifier string) (KeyValueTags, error) {
 	return Elbv2KeyValueTags(output.TagDescriptions[0].Tags), nil
 }
 
+// EmrcontainersListTags lists emrcontainers service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EmrcontainersListTags(conn *emrcontainers.EMRContainers, identifier string) (KeyValueTags, error) {
+	input := &emrcontainers.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return EmrcontainersKeyValueTags(output.Tags), nil
+}
+
 // FirehoseListTags lists firehose service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1418,6 +1495,23 @@ func MediastoreListTags(conn *mediastore.MediaStore, identifier string) (KeyValu
 	return MediastoreKeyValueTags(output.Tags), nil
 }
 
+// MemorydbListTags lists memorydb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MemorydbListTags(conn *memorydb.MemoryDB, identifier string) (KeyValueTags, error) {
+	input := &memorydb.ListTagsInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTags(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return MemorydbKeyValueTags(output.TagList), nil
+}
+
 // MqListTags lists mq service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1623,6 +1717,40 @@ func Route53ListTags(conn *route53.Route53, identifier string, resourceType stri
 	return Route53KeyValueTags(output.ResourceTagSet.Tags), nil
 }
 
+// Route53domainsListTags lists route53domains service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53domainsListTags(conn *route53domains.Route53Domains, identifier string) (KeyValueTags, error) {
+	input := &route53domains.ListTagsForDomainInput{
+		DomainName: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForDomain(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Route53domainsKeyValueTags(output.TagList), nil
+}
+
+// Route53recoveryreadinessListTags lists route53recoveryreadiness service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53recoveryreadinessListTags(conn *route53recoveryreadiness.Route53RecoveryReadiness, identifier string) (KeyValueTags, error) {
+	input := &route53recoveryreadiness.ListTagsForResourcesInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResources(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return Route53recoveryreadinessKeyValueTags(output.Tags), nil
+}
+
 // Route53resolverListTags lists route53resolver service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -1811,6 +1939,40 @@ func SsmListTags(conn *ssm.SSM, identifier string, resourceType string) (KeyValu
 	return SsmKeyValueTags(output.TagList), nil
 }
 
+// SsmcontactsListTags lists ssmcontacts service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SsmcontactsListTags(conn *ssmcontacts.SSMContacts, identifier string) (KeyValueTags, error) {
+	input := &ssmcontacts.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return SsmcontactsKeyValueTags(output.Tags), nil
+}
+
+// SsmincidentsListTags lists ssmincidents service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SsmincidentsListTags(conn *ssmincidents.SSMIncidents, identifier string) (KeyValueTags, error) {
+	input := &ssmincidents.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return SsmincidentsKeyValueTags(output.Tags), nil
+}
+
 // SsoadminListTags lists ssoadmin service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
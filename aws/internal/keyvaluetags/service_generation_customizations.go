@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appregistry"
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/aws/aws-sdk-go/service/appsync"
@@ -37,11 +38,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -59,6 +62,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
@@ -81,11 +85,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/aws/aws-sdk-go/service/neptune"
@@ -102,6 +108,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/schemas"
@@ -114,6 +122,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
@@ -151,6 +161,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(appconfig.New)
 	case "appmesh":
 		funcType = reflect.TypeOf(appmesh.New)
+	case "appregistry":
+		funcType = reflect.TypeOf(appregistry.New)
 	case "apprunner":
 		funcType = reflect.TypeOf(apprunner.New)
 	case "appstream":
@@ -197,6 +209,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(cognitoidentityprovider.New)
 	case "configservice":
 		funcType = reflect.TypeOf(configservice.New)
+	case "connect":
+		funcType = reflect.TypeOf(connect.New)
 	case "databasemigrationservice":
 		funcType = reflect.TypeOf(databasemigrationservice.New)
 	case "dataexchange":
@@ -207,6 +221,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(datasync.New)
 	case "dax":
 		funcType = reflect.TypeOf(dax.New)
+	case "detective":
+		funcType = reflect.TypeOf(detective.New)
 	case "devicefarm":
 		funcType = reflect.TypeOf(devicefarm.New)
 	case "directconnect":
@@ -241,6 +257,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(elbv2.New)
 	case "emr":
 		funcType = reflect.TypeOf(emr.New)
+	case "emrcontainers":
+		funcType = reflect.TypeOf(emrcontainers.New)
 	case "firehose":
 		funcType = reflect.TypeOf(firehose.New)
 	case "fsx":
@@ -285,6 +303,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
 		funcType = reflect.TypeOf(lightsail.New)
+	case "locationservice":
+		funcType = reflect.TypeOf(locationservice.New)
 	case "mediaconnect":
 		funcType = reflect.TypeOf(mediaconnect.New)
 	case "mediaconvert":
@@ -295,6 +315,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(mediapackage.New)
 	case "mediastore":
 		funcType = reflect.TypeOf(mediastore.New)
+	case "memorydb":
+		funcType = reflect.TypeOf(memorydb.New)
 	case "mq":
 		funcType = reflect.TypeOf(mq.New)
 	case "mwaa":
@@ -327,6 +349,10 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(resourcegroupstaggingapi.New)
 	case "route53":
 		funcType = reflect.TypeOf(route53.New)
+	case "route53domains":
+		funcType = reflect.TypeOf(route53domains.New)
+	case "route53recoveryreadiness":
+		funcType = reflect.TypeOf(route53recoveryreadiness.New)
 	case "route53resolver":
 		funcType = reflect.TypeOf(route53resolver.New)
 	case "sagemaker":
@@ -351,6 +377,10 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(sqs.New)
 	case "ssm":
 		funcType = reflect.TypeOf(ssm.New)
+	case "ssmcontacts":
+		funcType = reflect.TypeOf(ssmcontacts.New)
+	case "ssmincidents":
+		funcType = reflect.TypeOf(ssmincidents.New)
 	case "ssoadmin":
 		funcType = reflect.TypeOf(ssoadmin.New)
 	case "storagegateway":
@@ -431,6 +461,8 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "ListResourceTags"
 	case "lambda":
 		return "ListTags"
+	case "memorydb":
+		return "ListTags"
 	case "mq":
 		return "ListTags"
 	case "mwaa":
@@ -441,6 +473,10 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "DescribeTags"
 	case "resourcegroups":
 		return "GetTags"
+	case "route53domains":
+		return "ListTagsForDomain"
+	case "route53recoveryreadiness":
+		return "ListTagsForResources"
 	case "sagemaker":
 		return "ListTags"
 	case "sqs":
@@ -524,6 +560,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagDescriptions[0].Tags"
 	case "mediaconvert":
 		return "ResourceTags.Tags"
+	case "memorydb":
+		return "TagList"
 	case "neptune":
 		return "TagList"
 	case "networkmanager":
@@ -534,6 +572,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagList"
 	case "route53":
 		return "ResourceTagSet.Tags"
+	case "route53domains":
+		return "TagList"
 	case "ssm":
 		return "TagList"
 	case "waf":
@@ -636,6 +676,8 @@ func ServiceTagFunction(serviceName string) string {
 		return "Tag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "UpdateTagsForDomain"
 	case "sagemaker":
 		return "AddTags"
 	case "shield":
@@ -752,6 +794,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "Arn"
 	case "route53":
 		return "ResourceId"
+	case "route53domains":
+		return "DomainName"
 	case "secretsmanager":
 		return "SecretId"
 	case "servicediscovery":
@@ -762,6 +806,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "QueueUrl"
 	case "ssm":
 		return "ResourceId"
+	case "ssmcontacts":
+		return "ResourceARN"
 	case "storagegateway":
 		return "ResourceARN"
 	case "timestreamwrite":
@@ -814,6 +860,8 @@ func ServiceTagInputTagsField(serviceName string) string {
 		return "TagsModel"
 	case "route53":
 		return "AddTags"
+	case "route53domains":
+		return "TagsToUpdate"
 	default:
 		return "Tags"
 	}
@@ -998,6 +1046,8 @@ func ServiceUntagFunction(serviceName string) string {
 		return "Untag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "DeleteTagsForDomain"
 	case "sagemaker":
 		return "DeleteTags"
 	case "sqs":
@@ -1074,6 +1124,8 @@ func ServiceUntagInputTagsField(serviceName string) string {
 		return "Keys"
 	case "route53":
 		return "RemoveTagKeys"
+	case "route53domains":
+		return "TagsToDelete"
 	default:
 		return "TagKeys"
 	}
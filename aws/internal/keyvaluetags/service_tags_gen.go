@@ -61,6 +61,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
@@ -71,6 +72,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -83,6 +85,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
@@ -147,6 +150,16 @@ func AppconfigKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// AppregistryTags returns appregistry service tags.
+func (tags KeyValueTags) AppregistryTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// AppregistryKeyValueTags creates KeyValueTags from appregistry service tags.
+func AppregistryKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // AppstreamTags returns appstream service tags.
 func (tags KeyValueTags) AppstreamTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -237,6 +250,16 @@ func CognitoidentityproviderKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// ConnectTags returns connect service tags.
+func (tags KeyValueTags) ConnectTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// ConnectKeyValueTags creates KeyValueTags from connect service tags.
+func ConnectKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DataexchangeTags returns dataexchange service tags.
 func (tags KeyValueTags) DataexchangeTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -247,6 +270,16 @@ func DataexchangeKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// DetectiveTags returns detective service tags.
+func (tags KeyValueTags) DetectiveTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// DetectiveKeyValueTags creates KeyValueTags from detective service tags.
+func DetectiveKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // DlmTags returns dlm service tags.
 func (tags KeyValueTags) DlmTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -267,6 +300,16 @@ func EksKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// EmrcontainersTags returns emrcontainers service tags.
+func (tags KeyValueTags) EmrcontainersTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// EmrcontainersKeyValueTags creates KeyValueTags from emrcontainers service tags.
+func EmrcontainersKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // GlacierTags returns glacier service tags.
 func (tags KeyValueTags) GlacierTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -347,6 +390,16 @@ func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// LocationserviceTags returns locationservice service tags.
+func (tags KeyValueTags) LocationserviceTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// LocationserviceKeyValueTags creates KeyValueTags from locationservice service tags.
+func LocationserviceKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // Macie2Tags returns macie2 service tags.
 func (tags KeyValueTags) Macie2Tags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -457,6 +510,16 @@ func ResourcegroupsKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// Route53recoveryreadinessTags returns route53recoveryreadiness service tags.
+func (tags KeyValueTags) Route53recoveryreadinessTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// Route53recoveryreadinessKeyValueTags creates KeyValueTags from route53recoveryreadiness service tags.
+func Route53recoveryreadinessKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // SchemasTags returns schemas service tags.
 func (tags KeyValueTags) SchemasTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -497,6 +560,16 @@ func SqsKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// SsmincidentsTags returns ssmincidents service tags.
+func (tags KeyValueTags) SsmincidentsTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// SsmincidentsKeyValueTags creates KeyValueTags from ssmincidents service tags.
+func SsmincidentsKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // SyntheticsTags returns synthetics service tags.
 func (tags KeyValueTags) SyntheticsTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -2164,6 +2237,33 @@ func MediastoreKeyValueTags(tags []*mediastore.Tag) KeyValueTags {
 	return New(m)
 }
 
+// MemorydbTags returns memorydb service tags.
+func (tags KeyValueTags) MemorydbTags() []*memorydb.Tag {
+	result := make([]*memorydb.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &memorydb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// MemorydbKeyValueTags creates KeyValueTags from memorydb service tags.
+func MemorydbKeyValueTags(tags []*memorydb.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // NeptuneTags returns neptune service tags.
 func (tags KeyValueTags) NeptuneTags() []*neptune.Tag {
 	result := make([]*neptune.Tag, 0, len(tags))
@@ -2434,6 +2534,33 @@ func Route53KeyValueTags(tags []*route53.Tag) KeyValueTags {
 	return New(m)
 }
 
+// Route53domainsTags returns route53domains service tags.
+func (tags KeyValueTags) Route53domainsTags() []*route53domains.Tag {
+	result := make([]*route53domains.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &route53domains.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Route53domainsKeyValueTags creates KeyValueTags from route53domains service tags.
+func Route53domainsKeyValueTags(tags []*route53domains.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // Route53resolverTags returns route53resolver service tags.
 func (tags KeyValueTags) Route53resolverTags() []*route53resolver.Tag {
 	result := make([]*route53resolver.Tag, 0, len(tags))
@@ -2758,6 +2885,33 @@ func SsmKeyValueTags(tags []*ssm.Tag) KeyValueTags {
 	return New(m)
 }
 
+// SsmcontactsTags returns ssmcontacts service tags.
+func (tags KeyValueTags) SsmcontactsTags() []*ssmcontacts.Tag {
+	result := make([]*ssmcontacts.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ssmcontacts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SsmcontactsKeyValueTags creates KeyValueTags from ssmcontacts service tags.
+func SsmcontactsKeyValueTags(tags []*ssmcontacts.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // SsoadminTags returns ssoadmin service tags.
 func (tags KeyValueTags) SsoadminTags() []*ssoadmin.Tag {
 	result := make([]*ssoadmin.Tag, 0, len(tags))
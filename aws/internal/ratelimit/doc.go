@@ -0,0 +1,2 @@
+// ratelimit contains client-side rate limiters for AWS API requests.
+package ratelimit
@@ -0,0 +1,190 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate at which requests are sent.
+type Limiter interface {
+	// Wait blocks until a request may be sent or the context is done.
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a Limiter that allows requests at a steady rate, with bursts of up to
+// its capacity. A TokenBucket with a rate of zero does not limit requests.
+type TokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a full TokenBucket refilling at rate tokens per second.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay := b.take(time.Now())
+
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Rate returns the number of tokens added to the bucket per second.
+func (b *TokenBucket) Rate() float64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.rate
+}
+
+// SetRate changes the number of tokens added to the bucket per second.
+func (b *TokenBucket) SetRate(rate float64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill(time.Now())
+	b.rate = rate
+}
+
+// take removes a token from the bucket, returning zero, or returns the
+// time until a token is available.
+func (b *TokenBucket) take(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.rate <= 0 {
+		return 0
+	}
+
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() && b.rate > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+
+	b.last = now
+}
+
+const (
+	// Multiplier applied to the sending rate when a request is throttled.
+	adaptiveRateDecrease = 0.7
+	// Lowest sending rate, in requests per second.
+	adaptiveRateMinimum = 0.5
+	// Weight of the latest measurement in the smoothed sending rate.
+	adaptiveRateSmoothing = 0.8
+)
+
+// AdaptiveLimiter is a Limiter that does not limit requests until a request is throttled.
+// It then limits the sending rate to a fraction of the measured sending rate,
+// decreasing it further on each throttled request and increasing it gradually
+// as requests succeed.
+type AdaptiveLimiter struct {
+	bucket *TokenBucket
+
+	lock         sync.Mutex
+	measuredRate float64
+	sent         int
+	windowStart  time.Time
+}
+
+// NewAdaptiveLimiter returns a new, initially unlimited, AdaptiveLimiter.
+func NewAdaptiveLimiter() *AdaptiveLimiter {
+	return &AdaptiveLimiter{
+		bucket: NewTokenBucket(0, 1),
+	}
+}
+
+func (l *AdaptiveLimiter) Wait(ctx context.Context) error {
+	if err := l.bucket.Wait(ctx); err != nil {
+		return err
+	}
+
+	l.measure(time.Now())
+
+	return nil
+}
+
+// Rate returns the current sending rate limit in requests per second,
+// or zero if requests are not limited.
+func (l *AdaptiveLimiter) Rate() float64 {
+	return l.bucket.Rate()
+}
+
+// Throttled records a throttled request, decreasing the sending rate limit.
+func (l *AdaptiveLimiter) Throttled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	rate := l.bucket.Rate()
+
+	if rate == 0 || (l.measuredRate > 0 && l.measuredRate < rate) {
+		rate = l.measuredRate
+	}
+
+	l.bucket.SetRate(math.Max(adaptiveRateMinimum, rate*adaptiveRateDecrease))
+}
+
+// Succeeded records a successful request, increasing the sending rate limit
+// by roughly one request per second each second.
+func (l *AdaptiveLimiter) Succeeded() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if rate := l.bucket.Rate(); rate > 0 {
+		l.bucket.SetRate(rate + 1/rate)
+	}
+}
+
+// measure records a sent request, updating the smoothed sending rate once per second.
+func (l *AdaptiveLimiter) measure(now time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.windowStart.IsZero() {
+		l.windowStart = now
+	}
+
+	l.sent++
+
+	if elapsed := now.Sub(l.windowStart).Seconds(); elapsed >= 1 {
+		rate := float64(l.sent) / elapsed
+		l.measuredRate = adaptiveRateSmoothing*rate + (1-adaptiveRateSmoothing)*l.measuredRate
+		l.sent = 0
+		l.windowStart = now
+	}
+}
@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketTake(t *testing.T) {
+	bucket := NewTokenBucket(2, 2)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if got := bucket.take(now); got != 0 {
+			t.Fatalf("take %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got, expected := bucket.take(now), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	if got := bucket.take(now.Add(500 * time.Millisecond)); got != 0 {
+		t.Errorf("got delay %s after refill, expected none", got)
+	}
+}
+
+func TestTokenBucketTake_unlimited(t *testing.T) {
+	bucket := NewTokenBucket(0, 1)
+	now := time.Now()
+
+	for i := 0; i < 100; i++ {
+		if got := bucket.take(now); got != 0 {
+			t.Fatalf("take %d: got delay %s, expected none", i, got)
+		}
+	}
+}
+
+func TestTokenBucketWait_contextDone(t *testing.T) {
+	bucket := NewTokenBucket(0.001, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := bucket.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if err := bucket.Wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	limiter := NewAdaptiveLimiter()
+
+	limiter.Succeeded()
+
+	if got := limiter.Rate(); got != 0 {
+		t.Fatalf("got rate %f before throttling, expected none", got)
+	}
+
+	now := time.Now()
+
+	for i := 0; i <= 10; i++ {
+		limiter.measure(now.Add(time.Duration(i) * 100 * time.Millisecond))
+	}
+
+	limiter.Throttled()
+
+	if got, expected := limiter.Rate(), 11*adaptiveRateSmoothing*adaptiveRateDecrease; !approximatelyEqual(got, expected) {
+		t.Fatalf("got rate %f after throttling, expected %f", got, expected)
+	}
+
+	throttledRate := limiter.Rate()
+
+	limiter.Succeeded()
+
+	if got := limiter.Rate(); got <= throttledRate {
+		t.Errorf("got rate %f after success, expected more than %f", got, throttledRate)
+	}
+
+	for i := 0; i < 20; i++ {
+		limiter.Throttled()
+	}
+
+	if got, expected := limiter.Rate(), adaptiveRateMinimum; !approximatelyEqual(got, expected) {
+		t.Errorf("got rate %f after repeated throttling, expected %f", got, expected)
+	}
+}
+
+func approximatelyEqual(a, b float64) bool {
+	d := a - b
+
+	return d < 0.0001 && d > -0.0001
+}
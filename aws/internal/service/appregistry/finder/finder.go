@@ -0,0 +1,154 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ApplicationByID returns the AppRegistry application corresponding to the specified ID or name.
+func ApplicationByID(ctx context.Context, conn *appregistry.AppRegistry, id string) (*appregistry.GetApplicationOutput, error) {
+	input := &appregistry.GetApplicationInput{
+		Application: aws.String(id),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// AttributeGroupByID returns the AppRegistry attribute group corresponding to the specified ID or name.
+func AttributeGroupByID(ctx context.Context, conn *appregistry.AppRegistry, id string) (*appregistry.GetAttributeGroupOutput, error) {
+	input := &appregistry.GetAttributeGroupInput{
+		AttributeGroup: aws.String(id),
+	}
+
+	output, err := conn.GetAttributeGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// AttributeGroupAssociationByID returns an error of type NotFoundError if the
+// specified attribute group is not associated with the specified application.
+func AttributeGroupAssociationByID(ctx context.Context, conn *appregistry.AppRegistry, applicationID, attributeGroupID string) error {
+	input := &appregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(applicationID),
+	}
+	found := false
+
+	err := conn.ListAssociatedAttributeGroupsPagesWithContext(ctx, input, func(page *appregistry.ListAssociatedAttributeGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttributeGroups {
+			if aws.StringValue(v) == attributeGroupID {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
+
+// ResourceAssociationByID returns the resource, identified by name or ARN, associated with the specified application.
+func ResourceAssociationByID(ctx context.Context, conn *appregistry.AppRegistry, applicationID, resourceName string) (*appregistry.ResourceInfo, error) {
+	input := &appregistry.ListAssociatedResourcesInput{
+		Application: aws.String(applicationID),
+	}
+	var output *appregistry.ResourceInfo
+
+	err := conn.ListAssociatedResourcesPagesWithContext(ctx, input, func(page *appregistry.ListAssociatedResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Resources {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == resourceName || aws.StringValue(v.Arn) == resourceName {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
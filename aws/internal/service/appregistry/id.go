@@ -0,0 +1,38 @@
+package appregistry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const attributeGroupAssociationIDSeparator = ","
+
+func AttributeGroupAssociationCreateID(applicationID, attributeGroupID string) string {
+	return strings.Join([]string{applicationID, attributeGroupID}, attributeGroupAssociationIDSeparator)
+}
+
+func AttributeGroupAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, attributeGroupAssociationIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected applicationID%sattributeGroupID", id, attributeGroupAssociationIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+const resourceAssociationIDSeparator = ","
+
+func ResourceAssociationCreateID(applicationID, resourceType, resource string) string {
+	return strings.Join([]string{applicationID, resourceType, resource}, resourceAssociationIDSeparator)
+}
+
+func ResourceAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceAssociationIDSeparator)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected applicationID%[2]sresourceType%[2]sresource", id, resourceAssociationIDSeparator)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,262 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// FleetByName returns the AppStream Fleet corresponding to the specified name.
+func FleetByName(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.Fleet, error) {
+	input := &appstream.DescribeFleetsInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeFleetsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Fleets) == 0 || output.Fleets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Fleets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Fleets[0], nil
+}
+
+// FleetStackAssociation returns nil when the AppStream Stack is associated with the AppStream Fleet.
+func FleetStackAssociation(ctx context.Context, conn *appstream.AppStream, fleetName, stackName string) error {
+	input := &appstream.ListAssociatedStacksInput{
+		FleetName: aws.String(fleetName),
+	}
+
+	found := false
+
+	err := listAssociatedStacksPages(ctx, conn, input, func(page *appstream.ListAssociatedStacksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Names {
+			if aws.StringValue(v) == stackName {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			Message:     "fleet stack association not found",
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
+
+// ImageBuilderByName returns the AppStream Image Builder corresponding to the specified name.
+func ImageBuilderByName(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.ImageBuilder, error) {
+	input := &appstream.DescribeImageBuildersInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeImageBuildersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ImageBuilders) == 0 || output.ImageBuilders[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ImageBuilders); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ImageBuilders[0], nil
+}
+
+// StackByName returns the AppStream Stack corresponding to the specified name.
+func StackByName(ctx context.Context, conn *appstream.AppStream, name string) (*appstream.Stack, error) {
+	input := &appstream.DescribeStacksInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeStacksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Stacks) == 0 || output.Stacks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Stacks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Stacks[0], nil
+}
+
+// UserByNameAndAuthType returns the AppStream User corresponding to the specified name and authentication type.
+func UserByNameAndAuthType(ctx context.Context, conn *appstream.AppStream, userName, authenticationType string) (*appstream.User, error) {
+	input := &appstream.DescribeUsersInput{
+		AuthenticationType: aws.String(authenticationType),
+	}
+
+	var result *appstream.User
+
+	err := describeUsersPages(ctx, conn, input, func(page *appstream.DescribeUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Users {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.UserName) == userName {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "user not found",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// UserStackAssociation returns the association between the AppStream User and the AppStream Stack.
+func UserStackAssociation(ctx context.Context, conn *appstream.AppStream, userName, authenticationType, stackName string) (*appstream.UserStackAssociation, error) {
+	input := &appstream.DescribeUserStackAssociationsInput{
+		AuthenticationType: aws.String(authenticationType),
+		StackName:          aws.String(stackName),
+		UserName:           aws.String(userName),
+	}
+
+	output, err := conn.DescribeUserStackAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.UserStackAssociations) == 0 || output.UserStackAssociations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.UserStackAssociations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.UserStackAssociations[0], nil
+}
+
+// describeUsersPages pages through DescribeUsers, which has no SDK paginator.
+func describeUsersPages(ctx context.Context, conn *appstream.AppStream, input *appstream.DescribeUsersInput, fn func(*appstream.DescribeUsersOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeUsersWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+// listAssociatedStacksPages pages through ListAssociatedStacks, which has no SDK paginator.
+func listAssociatedStacksPages(ctx context.Context, conn *appstream.AppStream, input *appstream.ListAssociatedStacksInput, fn func(*appstream.ListAssociatedStacksOutput, bool) bool) error {
+	for {
+		output, err := conn.ListAssociatedStacksWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package appstream
+
+import (
+	"fmt"
+	"strings"
+)
+
+const fleetStackAssociationResourceIDSeparator = "/"
+
+func FleetStackAssociationCreateResourceID(fleetName, stackName string) string {
+	parts := []string{fleetName, stackName}
+	id := strings.Join(parts, fleetStackAssociationResourceIDSeparator)
+
+	return id
+}
+
+func FleetStackAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fleetStackAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLEETNAME%[2]sSTACKNAME", id, fleetStackAssociationResourceIDSeparator)
+}
+
+const userResourceIDSeparator = "/"
+
+func UserCreateResourceID(userName, authenticationType string) string {
+	parts := []string{userName, authenticationType}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USERNAME%[2]sAUTHENTICATIONTYPE", id, userResourceIDSeparator)
+}
+
+const userStackAssociationResourceIDSeparator = "/"
+
+func UserStackAssociationCreateResourceID(userName, authenticationType, stackName string) string {
+	parts := []string{userName, authenticationType, stackName}
+	id := strings.Join(parts, userStackAssociationResourceIDSeparator)
+
+	return id
+}
+
+func UserStackAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, userStackAssociationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USERNAME%[2]sAUTHENTICATIONTYPE%[2]sSTACKNAME", id, userStackAssociationResourceIDSeparator)
+}
@@ -0,0 +1,131 @@
+package appstream_test
+
+import (
+	"testing"
+
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+)
+
+func TestFleetStackAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "fleet",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       "fleet/stack",
+			ExpectedPart0: "fleet",
+			ExpectedPart1: "stack",
+		},
+		{
+			TestName:      "empty first part",
+			InputID:       "/stack",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "fleet/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "fleet/stack/extra",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfappstream.FleetStackAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
+
+func TestUserStackAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+		ExpectedPart2 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       "user@example.com/USERPOOL",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "user@example.com/USERPOOL/stack",
+			ExpectedPart0: "user@example.com",
+			ExpectedPart1: "USERPOOL",
+			ExpectedPart2: "stack",
+		},
+		{
+			TestName:      "empty middle part",
+			InputID:       "user@example.com//stack",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, gotPart2, err := tfappstream.UserStackAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+
+			if gotPart2 != testCase.ExpectedPart2 {
+				t.Errorf("got part 2 %s, expected %s", gotPart2, testCase.ExpectedPart2)
+			}
+		})
+	}
+}
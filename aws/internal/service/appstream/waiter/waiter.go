@@ -0,0 +1,128 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	FleetStateTimeout = 180 * time.Minute
+
+	ImageBuilderStateTimeout  = 60 * time.Minute
+	ImageBuilderDeleteTimeout = 10 * time.Minute
+)
+
+// FleetStateRunning waits for AppStream Fleet to reach a running state.
+func FleetStateRunning(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStarting},
+		Target:  []string{appstream.FleetStateRunning},
+		Refresh: FleetState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.Fleet); ok {
+		if errs := output.FleetErrors; len(errs) > 0 {
+			tfresource.SetLastError(err, fleetErrors(errs))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// FleetStateStopped waits for AppStream Fleet to reach a stopped state.
+func FleetStateStopped(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.FleetStateStopping},
+		Target:  []string{appstream.FleetStateStopped},
+		Refresh: FleetState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.Fleet); ok {
+		if errs := output.FleetErrors; len(errs) > 0 {
+			tfresource.SetLastError(err, fleetErrors(errs))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderStateRunning waits for AppStream Image Builder to reach a running state.
+func ImageBuilderStateRunning(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStatePending},
+		Target:  []string{appstream.ImageBuilderStateRunning},
+		Refresh: ImageBuilderState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		if state, errs := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errs) > 0 {
+			tfresource.SetLastError(err, imageBuilderErrors(errs))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ImageBuilderStateDeleted waits for AppStream Image Builder to be deleted.
+func ImageBuilderStateDeleted(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) (*appstream.ImageBuilder, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appstream.ImageBuilderStatePending, appstream.ImageBuilderStateDeleting},
+		Target:  []string{},
+		Refresh: ImageBuilderState(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
+		if state, errs := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errs) > 0 {
+			tfresource.SetLastError(err, imageBuilderErrors(errs))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func fleetErrors(apiObjects []*appstream.FleetError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func imageBuilderErrors(apiObjects []*appstream.ResourceError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,593 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ContactFlowByID returns the Connect Contact Flow corresponding to the specified ID.
+func ContactFlowByID(ctx context.Context, conn *connect.Connect, instanceID, contactFlowID string) (*connect.ContactFlow, error) {
+	input := &connect.DescribeContactFlowInput{
+		InstanceId:    aws.String(instanceID),
+		ContactFlowId: aws.String(contactFlowID),
+	}
+
+	output, err := conn.DescribeContactFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactFlow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactFlow, nil
+}
+
+// ContactFlowSummaryByName returns the Connect Contact Flow summary corresponding to the specified name.
+func ContactFlowSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.ContactFlowSummary, error) {
+	input := &connect.ListContactFlowsInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.ContactFlowSummary
+
+	err := conn.ListContactFlowsPagesWithContext(ctx, input, func(page *connect.ListContactFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContactFlowSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// HoursOfOperationByID returns the Connect Hours of Operation corresponding to the specified ID.
+func HoursOfOperationByID(ctx context.Context, conn *connect.Connect, instanceID, hoursOfOperationID string) (*connect.HoursOfOperation, error) {
+	input := &connect.DescribeHoursOfOperationInput{
+		InstanceId:         aws.String(instanceID),
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+	}
+
+	output, err := conn.DescribeHoursOfOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HoursOfOperation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HoursOfOperation, nil
+}
+
+// HoursOfOperationSummaryByName returns the Connect Hours of Operation summary corresponding to the specified name.
+func HoursOfOperationSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.HoursOfOperationSummary, error) {
+	input := &connect.ListHoursOfOperationsInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.HoursOfOperationSummary
+
+	err := conn.ListHoursOfOperationsPagesWithContext(ctx, input, func(page *connect.ListHoursOfOperationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.HoursOfOperationSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// InstanceAttribute returns the value of the specified attribute of the Connect Instance.
+func InstanceAttribute(ctx context.Context, conn *connect.Connect, instanceID, attributeType string) (string, error) {
+	input := &connect.DescribeInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeInstanceAttributeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Attribute == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Attribute.Value), nil
+}
+
+// InstanceByID returns the Connect Instance corresponding to the specified ID.
+func InstanceByID(ctx context.Context, conn *connect.Connect, id string) (*connect.Instance, error) {
+	input := &connect.DescribeInstanceInput{
+		InstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Instance == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Instance, nil
+}
+
+// InstanceSummaryByAlias returns the Connect Instance summary corresponding to the specified alias.
+func InstanceSummaryByAlias(ctx context.Context, conn *connect.Connect, alias string) (*connect.InstanceSummary, error) {
+	input := &connect.ListInstancesInput{}
+	var result *connect.InstanceSummary
+
+	err := conn.ListInstancesPagesWithContext(ctx, input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.InstanceAlias) == alias {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// LambdaFunctionAssociationByARN returns the ARN of the Lambda function associated with the Connect Instance.
+func LambdaFunctionAssociationByARN(ctx context.Context, conn *connect.Connect, instanceID, functionARN string) (string, error) {
+	input := &connect.ListLambdaFunctionsInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result string
+
+	err := conn.ListLambdaFunctionsPagesWithContext(ctx, input, func(page *connect.ListLambdaFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LambdaFunctions {
+			if aws.StringValue(v) == functionARN {
+				result = functionARN
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if result == "" {
+		return "", &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// QueueByID returns the Connect Queue corresponding to the specified ID.
+func QueueByID(ctx context.Context, conn *connect.Connect, instanceID, queueID string) (*connect.Queue, error) {
+	input := &connect.DescribeQueueInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+	}
+
+	output, err := conn.DescribeQueueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+// QueueQuickConnectIDsByID returns the IDs of the quick connects associated with the Connect Queue corresponding to the specified ID.
+func QueueQuickConnectIDsByID(ctx context.Context, conn *connect.Connect, instanceID, queueID string) ([]string, error) {
+	input := &connect.ListQueueQuickConnectsInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+	}
+	var result []string
+
+	err := conn.ListQueueQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQueueQuickConnectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.QuickConnectSummaryList {
+			if v != nil {
+				result = append(result, aws.StringValue(v.Id))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// QueueSummaryByName returns the Connect Queue summary corresponding to the specified name.
+func QueueSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.QueueSummary, error) {
+	input := &connect.ListQueuesInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.QueueSummary
+
+	err := conn.ListQueuesPagesWithContext(ctx, input, func(page *connect.ListQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.QueueSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// RoutingProfileByID returns the Connect Routing Profile corresponding to the specified ID.
+func RoutingProfileByID(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) (*connect.RoutingProfile, error) {
+	input := &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+
+	output, err := conn.DescribeRoutingProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RoutingProfile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RoutingProfile, nil
+}
+
+// RoutingProfileQueueConfigsByID returns the queue configurations of the Connect Routing Profile corresponding to the specified ID.
+func RoutingProfileQueueConfigsByID(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) ([]*connect.RoutingProfileQueueConfigSummary, error) {
+	input := &connect.ListRoutingProfileQueuesInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+	var result []*connect.RoutingProfileQueueConfigSummary
+
+	err := conn.ListRoutingProfileQueuesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfileQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileQueueConfigSummaryList {
+			if v != nil {
+				result = append(result, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RoutingProfileSummaryByName returns the Connect Routing Profile summary corresponding to the specified name.
+func RoutingProfileSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.RoutingProfileSummary, error) {
+	input := &connect.ListRoutingProfilesInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.RoutingProfileSummary
+
+	err := conn.ListRoutingProfilesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// SecurityProfileSummaryByName returns the Connect Security Profile summary corresponding to the specified name.
+func SecurityProfileSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.SecurityProfileSummary, error) {
+	input := &connect.ListSecurityProfilesInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.SecurityProfileSummary
+
+	err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityProfileSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// SecurityProfileSummaryByID returns the Connect Security Profile summary corresponding to the specified ID.
+func SecurityProfileSummaryByID(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) (*connect.SecurityProfileSummary, error) {
+	input := &connect.ListSecurityProfilesInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var result *connect.SecurityProfileSummary
+
+	err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityProfileSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Id) == securityProfileID {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
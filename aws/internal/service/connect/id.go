@@ -0,0 +1,110 @@
+package connect
+
+import (
+	"fmt"
+	"strings"
+)
+
+const contactFlowResourceIDSeparator = ":"
+
+func ContactFlowCreateResourceID(instanceID, contactFlowID string) string {
+	parts := []string{instanceID, contactFlowID}
+	id := strings.Join(parts, contactFlowResourceIDSeparator)
+
+	return id
+}
+
+func ContactFlowParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, contactFlowResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE_ID%[2]sCONTACT_FLOW_ID", id, contactFlowResourceIDSeparator)
+}
+
+const hoursOfOperationResourceIDSeparator = ":"
+
+func HoursOfOperationCreateResourceID(instanceID, hoursOfOperationID string) string {
+	parts := []string{instanceID, hoursOfOperationID}
+	id := strings.Join(parts, hoursOfOperationResourceIDSeparator)
+
+	return id
+}
+
+func HoursOfOperationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, hoursOfOperationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE_ID%[2]sHOURS_OF_OPERATION_ID", id, hoursOfOperationResourceIDSeparator)
+}
+
+const lambdaFunctionAssociationResourceIDSeparator = ","
+
+func LambdaFunctionAssociationCreateResourceID(instanceID, functionARN string) string {
+	parts := []string{instanceID, functionARN}
+	id := strings.Join(parts, lambdaFunctionAssociationResourceIDSeparator)
+
+	return id
+}
+
+func LambdaFunctionAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, lambdaFunctionAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE_ID%[2]sFUNCTION_ARN", id, lambdaFunctionAssociationResourceIDSeparator)
+}
+
+const queueResourceIDSeparator = ":"
+
+func QueueCreateResourceID(instanceID, queueID string) string {
+	parts := []string{instanceID, queueID}
+	id := strings.Join(parts, queueResourceIDSeparator)
+
+	return id
+}
+
+func QueueParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, queueResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE_ID%[2]sQUEUE_ID", id, queueResourceIDSeparator)
+}
+
+const routingProfileResourceIDSeparator = ":"
+
+func RoutingProfileCreateResourceID(instanceID, routingProfileID string) string {
+	parts := []string{instanceID, routingProfileID}
+	id := strings.Join(parts, routingProfileResourceIDSeparator)
+
+	return id
+}
+
+func RoutingProfileParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, routingProfileResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INSTANCE_ID%[2]sROUTING_PROFILE_ID", id, routingProfileResourceIDSeparator)
+}
+
+const securityProfileResourceIDSeparator = ":"
+
+func SecurityProfileCreateResourceID(instanceID, securityProfileID string) string {
+	parts := []string{instanceID, securityProfileID}
+	id := strings.Join(parts, securityProfileResourceIDSeparator)
+
+	return id
+}
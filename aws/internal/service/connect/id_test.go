@@ -0,0 +1,120 @@
+package connect_test
+
+import (
+	"testing"
+
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+)
+
+func TestContactFlowParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111:example:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfconnect.ContactFlowCreateResourceID("aaaaaaaa-bbbb-cccc-dddd-111111111111", "example"),
+			ExpectedPart0: "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+			ExpectedPart1: "example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfconnect.ContactFlowParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
+
+func TestLambdaFunctionAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "aaaaaaaa-bbbb-cccc-dddd-111111111111,",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfconnect.LambdaFunctionAssociationCreateResourceID("aaaaaaaa-bbbb-cccc-dddd-111111111111", "arn:aws:lambda:us-west-2:123456789012:function:example"),
+			ExpectedPart0: "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+			ExpectedPart1: "arn:aws:lambda:us-west-2:123456789012:function:example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfconnect.LambdaFunctionAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	InstanceCreatedTimeout = 5 * time.Minute
+	InstanceDeletedTimeout = 5 * time.Minute
+)
+
+// InstanceCreated waits for a Connect Instance to finish creating.
+func InstanceCreated(ctx context.Context, conn *connect.Connect, id string, timeout time.Duration) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusCreationInProgress},
+		Target:  []string{connect.InstanceStatusActive},
+		Refresh: InstanceStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		if v := output.StatusReason; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// InstanceDeleted waits for a Connect Instance to be deleted.
+func InstanceDeleted(ctx context.Context, conn *connect.Connect, id string, timeout time.Duration) (*connect.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connect.InstanceStatusActive},
+		Target:  []string{},
+		Refresh: InstanceStatus(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
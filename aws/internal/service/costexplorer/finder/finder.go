@@ -0,0 +1,97 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// AnomalyMonitorByARN returns the Cost Explorer Anomaly Monitor corresponding to the specified ARN.
+func AnomalyMonitorByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalyMonitor, error) {
+	input := &costexplorer.GetAnomalyMonitorsInput{
+		MonitorArnList: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetAnomalyMonitorsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AnomalyMonitors) == 0 || output.AnomalyMonitors[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.AnomalyMonitors); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.AnomalyMonitors[0], nil
+}
+
+// AnomalySubscriptionByARN returns the Cost Explorer Anomaly Subscription corresponding to the specified ARN.
+func AnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.AnomalySubscription, error) {
+	input := &costexplorer.GetAnomalySubscriptionsInput{
+		SubscriptionArnList: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetAnomalySubscriptionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownSubscriptionException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AnomalySubscriptions) == 0 || output.AnomalySubscriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.AnomalySubscriptions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.AnomalySubscriptions[0], nil
+}
+
+// CostCategoryByARN returns the Cost Explorer Cost Category corresponding to the specified ARN.
+func CostCategoryByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.CostCategory, error) {
+	input := &costexplorer.DescribeCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCostCategoryDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CostCategory == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CostCategory, nil
+}
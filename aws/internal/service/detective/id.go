@@ -0,0 +1,25 @@
+package detective
+
+import (
+	"fmt"
+	"strings"
+)
+
+const memberResourceIDSeparator = "/"
+
+func MemberCreateResourceID(graphARN, accountID string) string {
+	parts := []string{graphARN, accountID}
+	id := strings.Join(parts, memberResourceIDSeparator)
+
+	return id
+}
+
+func MemberParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, memberResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GRAPH_ARN%[2]sACCOUNT_ID", id, memberResourceIDSeparator)
+}
@@ -0,0 +1,71 @@
+package detective_test
+
+import (
+	"testing"
+
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+)
+
+func TestMemberParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty graph ARN",
+			InputID:       "/123456789012",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty account ID",
+			InputID:       "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789/123456789012/extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfdetective.MemberCreateResourceID("arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789", "210987654321"),
+			ExpectedPart0: "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789",
+			ExpectedPart1: "210987654321",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfdetective.MemberParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// MemberStatus fetches the Detective Member and its status.
+func MemberStatus(ctx context.Context, conn *detective.Detective, graphARN, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.MemberByTwoPartKey(ctx, conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
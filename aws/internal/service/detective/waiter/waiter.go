@@ -0,0 +1,31 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	MemberInvitedTimeout = 4 * time.Minute
+)
+
+// MemberInvited waits for a Detective Member's email verification to finish.
+func MemberInvited(ctx context.Context, conn *detective.Detective, graphARN, accountID string, timeout time.Duration) (*detective.MemberDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{detective.MemberStatusVerificationInProgress},
+		Target:  []string{detective.MemberStatusInvited, detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled},
+		Refresh: MemberStatus(ctx, conn, graphARN, accountID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.MemberDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,32 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	VirtualClusterDeletedTimeout = 90 * time.Minute
+)
+
+// VirtualClusterDeleted waits for an EMR containers virtual cluster to be deleted.
+func VirtualClusterDeleted(ctx context.Context, conn *emrcontainers.EMRContainers, id string, timeout time.Duration) (*emrcontainers.VirtualCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.VirtualClusterStateTerminating},
+		Target:  []string{},
+		Refresh: VirtualClusterStatus(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrcontainers.VirtualCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,184 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// GeofenceCollectionByName returns the Location Service Geofence Collection corresponding to the specified name.
+func GeofenceCollectionByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeGeofenceCollectionOutput, error) {
+	input := &locationservice.DescribeGeofenceCollectionInput{
+		CollectionName: aws.String(name),
+	}
+
+	output, err := conn.DescribeGeofenceCollectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// MapByName returns the Location Service Map corresponding to the specified name.
+func MapByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeMapOutput, error) {
+	input := &locationservice.DescribeMapInput{
+		MapName: aws.String(name),
+	}
+
+	output, err := conn.DescribeMapWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// PlaceIndexByName returns the Location Service Place Index corresponding to the specified name.
+func PlaceIndexByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribePlaceIndexOutput, error) {
+	input := &locationservice.DescribePlaceIndexInput{
+		IndexName: aws.String(name),
+	}
+
+	output, err := conn.DescribePlaceIndexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// RouteCalculatorByName returns the Location Service Route Calculator corresponding to the specified name.
+func RouteCalculatorByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeRouteCalculatorOutput, error) {
+	input := &locationservice.DescribeRouteCalculatorInput{
+		CalculatorName: aws.String(name),
+	}
+
+	output, err := conn.DescribeRouteCalculatorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// TrackerByName returns the Location Service Tracker corresponding to the specified name.
+func TrackerByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeTrackerOutput, error) {
+	input := &locationservice.DescribeTrackerInput{
+		TrackerName: aws.String(name),
+	}
+
+	output, err := conn.DescribeTrackerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// TrackerConsumerByTwoPartKey returns the ARN of the consumer associated with the specified Location Service Tracker.
+func TrackerConsumerByTwoPartKey(ctx context.Context, conn *locationservice.LocationService, trackerName, consumerARN string) (string, error) {
+	input := &locationservice.ListTrackerConsumersInput{
+		TrackerName: aws.String(trackerName),
+	}
+	var found bool
+
+	err := conn.ListTrackerConsumersPagesWithContext(ctx, input, func(page *locationservice.ListTrackerConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConsumerArns {
+			if aws.StringValue(v) == consumerARN {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "", &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return consumerARN, nil
+}
@@ -0,0 +1,25 @@
+package locationservice
+
+import (
+	"fmt"
+	"strings"
+)
+
+const trackerAssociationResourceIDSeparator = "|"
+
+func TrackerAssociationCreateResourceID(trackerName, consumerARN string) string {
+	parts := []string{trackerName, consumerARN}
+	id := strings.Join(parts, trackerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func TrackerAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trackerAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRACKER_NAME%[2]sCONSUMER_ARN", id, trackerAssociationResourceIDSeparator)
+}
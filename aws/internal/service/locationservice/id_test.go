@@ -0,0 +1,71 @@
+package locationservice_test
+
+import (
+	"testing"
+
+	tflocationservice "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice"
+)
+
+func TestTrackerAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "example-tracker",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty tracker name",
+			InputID:       "|arn:aws:geo:us-east-1:123456789012:geofence-collection/example",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty consumer ARN",
+			InputID:       "example-tracker|",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "example-tracker|arn:aws:geo:us-east-1:123456789012:geofence-collection/example|extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tflocationservice.TrackerAssociationCreateResourceID("example-tracker", "arn:aws:geo:us-east-1:123456789012:geofence-collection/example"),
+			ExpectedPart0: "example-tracker",
+			ExpectedPart1: "arn:aws:geo:us-east-1:123456789012:geofence-collection/example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tflocationservice.TrackerAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package memorydb
+
+const (
+	ACLStatusActive    = "active"
+	ACLStatusCreating  = "creating"
+	ACLStatusDeleting  = "deleting"
+	ACLStatusModifying = "modifying"
+)
+
+const (
+	ClusterStatusAvailable    = "available"
+	ClusterStatusCreating     = "creating"
+	ClusterStatusDeleting     = "deleting"
+	ClusterStatusSnapshotting = "snapshotting"
+	ClusterStatusUpdating     = "updating"
+)
+
+const (
+	ClusterSecurityGroupStatusActive = "active"
+)
+
+const (
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCopying   = "copying"
+	SnapshotStatusCreating  = "creating"
+	SnapshotStatusDeleting  = "deleting"
+)
+
+const (
+	SnsTopicStatusActive   = "ACTIVE"
+	SnsTopicStatusInactive = "INACTIVE"
+)
+
+const (
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+)
@@ -0,0 +1,104 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfmemorydb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ACLStatus fetches the MemoryDB ACL and its status.
+func ACLStatus(ctx context.Context, conn *memorydb.MemoryDB, aclName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		acl, err := finder.ACLByName(ctx, conn, aclName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return acl, aws.StringValue(acl.Status), nil
+	}
+}
+
+// ClusterStatus fetches the MemoryDB Cluster and its status.
+func ClusterStatus(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := finder.ClusterByName(ctx, conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.Status), nil
+	}
+}
+
+// ClusterSecurityGroupsStatus fetches the MemoryDB Cluster and the status of
+// its security group memberships, which is active once all are active.
+func ClusterSecurityGroupsStatus(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := finder.ClusterByName(ctx, conn, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			if status := aws.StringValue(sg.Status); status != tfmemorydb.ClusterSecurityGroupStatusActive {
+				return cluster, status, nil
+			}
+		}
+
+		return cluster, tfmemorydb.ClusterSecurityGroupStatusActive, nil
+	}
+}
+
+// SnapshotStatus fetches the MemoryDB Snapshot and its status.
+func SnapshotStatus(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := finder.SnapshotByName(ctx, conn, snapshotName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
+// UserStatus fetches the MemoryDB User and its status.
+func UserStatus(ctx context.Context, conn *memorydb.MemoryDB, userName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := finder.UserByName(ctx, conn, userName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
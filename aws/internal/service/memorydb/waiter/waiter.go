@@ -0,0 +1,153 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfmemorydb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb"
+)
+
+const (
+	ACLActiveTimeout  = 5 * time.Minute
+	ACLDeletedTimeout = 5 * time.Minute
+
+	ClusterAvailableTimeout = 120 * time.Minute
+	ClusterDeletedTimeout   = 120 * time.Minute
+
+	ClusterSecurityGroupsActiveTimeout = 10 * time.Minute
+
+	SnapshotAvailableTimeout = 120 * time.Minute
+	SnapshotDeletedTimeout   = 120 * time.Minute
+
+	UserActiveTimeout  = 5 * time.Minute
+	UserDeletedTimeout = 5 * time.Minute
+)
+
+// ACLActive waits for MemoryDB ACL to reach an active state after modifications.
+func ACLActive(ctx context.Context, conn *memorydb.MemoryDB, aclName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.ACLStatusCreating, tfmemorydb.ACLStatusModifying},
+		Target:  []string{tfmemorydb.ACLStatusActive},
+		Refresh: ACLStatus(ctx, conn, aclName),
+		Timeout: ACLActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// ACLDeleted waits for MemoryDB ACL to be deleted.
+func ACLDeleted(ctx context.Context, conn *memorydb.MemoryDB, aclName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.ACLStatusDeleting},
+		Target:  []string{},
+		Refresh: ACLStatus(ctx, conn, aclName),
+		Timeout: ACLDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// ClusterAvailable waits for MemoryDB Cluster to reach an available state after modifications.
+func ClusterAvailable(ctx context.Context, conn *memorydb.MemoryDB, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.ClusterStatusCreating, tfmemorydb.ClusterStatusUpdating, tfmemorydb.ClusterStatusSnapshotting},
+		Target:  []string{tfmemorydb.ClusterStatusAvailable},
+		Refresh: ClusterStatus(ctx, conn, clusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// ClusterDeleted waits for MemoryDB Cluster to be deleted.
+func ClusterDeleted(ctx context.Context, conn *memorydb.MemoryDB, clusterName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.ClusterStatusDeleting},
+		Target:  []string{},
+		Refresh: ClusterStatus(ctx, conn, clusterName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// ClusterSecurityGroupsActive waits for MemoryDB Cluster to reach an active state after modifications
+// to its security groups.
+func ClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryDB, clusterName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"adding", "modifying", "removing"},
+		Target:  []string{tfmemorydb.ClusterSecurityGroupStatusActive},
+		Refresh: ClusterSecurityGroupsStatus(ctx, conn, clusterName),
+		Timeout: ClusterSecurityGroupsActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// SnapshotAvailable waits for MemoryDB Snapshot to reach an available state.
+func SnapshotAvailable(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.SnapshotStatusCreating, tfmemorydb.SnapshotStatusCopying},
+		Target:  []string{tfmemorydb.SnapshotStatusAvailable},
+		Refresh: SnapshotStatus(ctx, conn, snapshotName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// SnapshotDeleted waits for MemoryDB Snapshot to be deleted.
+func SnapshotDeleted(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.SnapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: SnapshotStatus(ctx, conn, snapshotName),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// UserActive waits for MemoryDB User to reach an active state after modifications.
+func UserActive(ctx context.Context, conn *memorydb.MemoryDB, userName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.UserStatusModifying},
+		Target:  []string{tfmemorydb.UserStatusActive},
+		Refresh: UserStatus(ctx, conn, userName),
+		Timeout: UserActiveTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// UserDeleted waits for MemoryDB User to be deleted.
+func UserDeleted(ctx context.Context, conn *memorydb.MemoryDB, userName string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{tfmemorydb.UserStatusDeleting},
+		Target:  []string{},
+		Refresh: UserStatus(ctx, conn, userName),
+		Timeout: UserDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
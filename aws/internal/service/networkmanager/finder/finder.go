@@ -0,0 +1,319 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// CustomerGatewayAssociationByTwoPartKey returns the Network Manager Customer Gateway Association
+// corresponding to the specified global network ID and customer gateway ARN.
+func CustomerGatewayAssociationByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string) (*networkmanager.CustomerGatewayAssociation, error) {
+	input := &networkmanager.GetCustomerGatewayAssociationsInput{
+		CustomerGatewayArns: aws.StringSlice([]string{customerGatewayARN}),
+		GlobalNetworkId:     aws.String(globalNetworkID),
+	}
+
+	output, err := conn.GetCustomerGatewayAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CustomerGatewayAssociations) == 0 || output.CustomerGatewayAssociations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CustomerGatewayAssociations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	association := output.CustomerGatewayAssociations[0]
+
+	if state := aws.StringValue(association.State); state == networkmanager.CustomerGatewayAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(association.GlobalNetworkId) != globalNetworkID || aws.StringValue(association.CustomerGatewayArn) != customerGatewayARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return association, nil
+}
+
+// DeviceByTwoPartKey returns the Network Manager Device corresponding to the specified global network ID and device ID.
+func DeviceByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	output, err := conn.GetDevicesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Devices) == 0 || output.Devices[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Devices); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	device := output.Devices[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(device.GlobalNetworkId) != globalNetworkID || aws.StringValue(device.DeviceId) != deviceID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return device, nil
+}
+
+// GlobalNetworkByID returns the Network Manager Global Network corresponding to the specified ID.
+func GlobalNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeGlobalNetworksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.GlobalNetworks) == 0 || output.GlobalNetworks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.GlobalNetworks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	globalNetwork := output.GlobalNetworks[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(globalNetwork.GlobalNetworkId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return globalNetwork, nil
+}
+
+// LinkByTwoPartKey returns the Network Manager Link corresponding to the specified global network ID and link ID.
+func LinkByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+
+	output, err := conn.GetLinksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Links) == 0 || output.Links[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Links); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	link := output.Links[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(link.GlobalNetworkId) != globalNetworkID || aws.StringValue(link.LinkId) != linkID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return link, nil
+}
+
+// LinkAssociationByThreePartKey returns the Network Manager Link Association
+// corresponding to the specified global network ID, link ID and device ID.
+func LinkAssociationByThreePartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	output, err := conn.GetLinkAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LinkAssociations) == 0 || output.LinkAssociations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.LinkAssociations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	association := output.LinkAssociations[0]
+
+	if state := aws.StringValue(association.LinkAssociationState); state == networkmanager.LinkAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(association.GlobalNetworkId) != globalNetworkID || aws.StringValue(association.LinkId) != linkID || aws.StringValue(association.DeviceId) != deviceID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return association, nil
+}
+
+// SiteByTwoPartKey returns the Network Manager Site corresponding to the specified global network ID and site ID.
+func SiteByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+
+	output, err := conn.GetSitesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Sites) == 0 || output.Sites[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Sites); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	site := output.Sites[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(site.GlobalNetworkId) != globalNetworkID || aws.StringValue(site.SiteId) != siteID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return site, nil
+}
+
+// TransitGatewayRegistrationByTwoPartKey returns the Network Manager Transit Gateway Registration
+// corresponding to the specified global network ID and transit gateway ARN.
+func TransitGatewayRegistrationByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	output, err := conn.GetTransitGatewayRegistrationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TransitGatewayRegistrations) == 0 || output.TransitGatewayRegistrations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.TransitGatewayRegistrations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	registration := output.TransitGatewayRegistrations[0]
+
+	if registration.State == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(registration.State.Code); state == networkmanager.TransitGatewayRegistrationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(registration.GlobalNetworkId) != globalNetworkID || aws.StringValue(registration.TransitGatewayArn) != transitGatewayARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return registration, nil
+}
@@ -0,0 +1,84 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// GlobalNetworkResourceARNParse returns the global network ID and resource ID
+// encoded in the ARN of a global network child resource such as a site, device or link,
+// e.g. arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223.
+func GlobalNetworkResourceARNParse(v string) (string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) == 3 && parts[1] != "" && parts[2] != "" {
+		return parts[1], parts[2], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ARN resource (%s), expected TYPE/GLOBAL_NETWORK_ID/RESOURCE_ID", parsedARN.Resource)
+}
+
+const customerGatewayAssociationResourceIDSeparator = ","
+
+func CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN string) string {
+	parts := []string{globalNetworkID, customerGatewayARN}
+	id := strings.Join(parts, customerGatewayAssociationResourceIDSeparator)
+
+	return id
+}
+
+func CustomerGatewayAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customerGatewayAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL_NETWORK_ID%[2]sCUSTOMER_GATEWAY_ARN", id, customerGatewayAssociationResourceIDSeparator)
+}
+
+const linkAssociationResourceIDSeparator = ","
+
+func LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationResourceIDSeparator)
+
+	return id
+}
+
+func LinkAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL_NETWORK_ID%[2]sLINK_ID%[2]sDEVICE_ID", id, linkAssociationResourceIDSeparator)
+}
+
+const transitGatewayRegistrationResourceIDSeparator = ","
+
+func TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationResourceIDSeparator)
+
+	return id
+}
+
+func TransitGatewayRegistrationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL_NETWORK_ID%[2]sTRANSIT_GATEWAY_ARN", id, transitGatewayRegistrationResourceIDSeparator)
+}
@@ -0,0 +1,181 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+)
+
+func TestGlobalNetworkResourceARNParse(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "not an ARN",
+			InputARN:      "site-444555aaabbb11223",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "global network ARN",
+			InputARN:      "arn:aws:networkmanager::123456789012:global-network/global-network-01231231231231231",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty resource ID",
+			InputARN:      "arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid site ARN",
+			InputARN:      "arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223",
+			ExpectedPart0: "global-network-01231231231231231",
+			ExpectedPart1: "site-444555aaabbb11223",
+		},
+		{
+			TestName:      "valid device ARN",
+			InputARN:      "arn:aws:networkmanager::123456789012:device/global-network-01231231231231231/device-07f6fd08867abc123",
+			ExpectedPart0: "global-network-01231231231231231",
+			ExpectedPart1: "device-07f6fd08867abc123",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfnetworkmanager.GlobalNetworkResourceARNParse(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
+
+func TestLinkAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+		ExpectedPart2 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "two parts",
+			InputID:       "global-network-01231231231231231,link-11112222aaaabbbb1",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty third part",
+			InputID:       "global-network-01231231231231231,link-11112222aaaabbbb1,",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfnetworkmanager.LinkAssociationCreateResourceID("global-network-01231231231231231", "link-11112222aaaabbbb1", "device-07f6fd08867abc123"),
+			ExpectedPart0: "global-network-01231231231231231",
+			ExpectedPart1: "link-11112222aaaabbbb1",
+			ExpectedPart2: "device-07f6fd08867abc123",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, gotPart2, err := tfnetworkmanager.LinkAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+
+			if gotPart2 != testCase.ExpectedPart2 {
+				t.Errorf("got part 2 %s, expected %s", gotPart2, testCase.ExpectedPart2)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRegistrationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "global-network-01231231231231231",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfnetworkmanager.TransitGatewayRegistrationCreateResourceID("global-network-01231231231231231", "arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-123abc05e04123abc"),
+			ExpectedPart0: "global-network-01231231231231231",
+			ExpectedPart1: "arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-123abc05e04123abc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfnetworkmanager.TransitGatewayRegistrationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
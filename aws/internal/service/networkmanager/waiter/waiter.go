@@ -0,0 +1,367 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	CustomerGatewayAssociationCreatedTimeout = 10 * time.Minute
+	CustomerGatewayAssociationDeletedTimeout = 10 * time.Minute
+
+	DeviceCreatedTimeout = 10 * time.Minute
+	DeviceDeletedTimeout = 10 * time.Minute
+	DeviceUpdatedTimeout = 10 * time.Minute
+
+	GlobalNetworkCreatedTimeout = 10 * time.Minute
+	GlobalNetworkDeletedTimeout = 10 * time.Minute
+	GlobalNetworkUpdatedTimeout = 10 * time.Minute
+
+	LinkAssociationCreatedTimeout = 10 * time.Minute
+	LinkAssociationDeletedTimeout = 10 * time.Minute
+
+	LinkCreatedTimeout = 10 * time.Minute
+	LinkDeletedTimeout = 10 * time.Minute
+	LinkUpdatedTimeout = 10 * time.Minute
+
+	SiteCreatedTimeout = 10 * time.Minute
+	SiteDeletedTimeout = 10 * time.Minute
+	SiteUpdatedTimeout = 10 * time.Minute
+
+	TransitGatewayRegistrationCreatedTimeout = 10 * time.Minute
+	TransitGatewayRegistrationDeletedTimeout = 10 * time.Minute
+)
+
+// CustomerGatewayAssociationCreated waits for a Network Manager Customer Gateway Association to be created.
+func CustomerGatewayAssociationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CustomerGatewayAssociationStatePending},
+		Target:  []string{networkmanager.CustomerGatewayAssociationStateAvailable},
+		Refresh: CustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// CustomerGatewayAssociationDeleted waits for a Network Manager Customer Gateway Association to be deleted.
+func CustomerGatewayAssociationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CustomerGatewayAssociationStateAvailable, networkmanager.CustomerGatewayAssociationStateDeleting},
+		Target:  []string{},
+		Refresh: CustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceCreated waits for a Network Manager Device to be created.
+func DeviceCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceState(ctx, conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceDeleted waits for a Network Manager Device to be deleted.
+func DeviceDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Refresh: DeviceState(ctx, conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceUpdated waits for a Network Manager Device to be updated.
+func DeviceUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceState(ctx, conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkCreated waits for a Network Manager Global Network to be created.
+func GlobalNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkDeleted waits for a Network Manager Global Network to be deleted.
+func GlobalNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: GlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkUpdated waits for a Network Manager Global Network to be updated.
+func GlobalNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkCreated waits for a Network Manager Link to be created.
+func LinkCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkState(ctx, conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkDeleted waits for a Network Manager Link to be deleted.
+func LinkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Refresh: LinkState(ctx, conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkUpdated waits for a Network Manager Link to be updated.
+func LinkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkState(ctx, conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationCreated waits for a Network Manager Link Association to be created.
+func LinkAssociationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Refresh: LinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationDeleted waits for a Network Manager Link Association to be deleted.
+func LinkAssociationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateAvailable, networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Refresh: LinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteCreated waits for a Network Manager Site to be created.
+func SiteCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteState(ctx, conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteDeleted waits for a Network Manager Site to be deleted.
+func SiteDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Refresh: SiteState(ctx, conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteUpdated waits for a Network Manager Site to be updated.
+func SiteUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteState(ctx, conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationCreated waits for a Network Manager Transit Gateway Registration to be created.
+func TransitGatewayRegistrationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Refresh: TransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if state := output.State; state != nil && aws.StringValue(state.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(state.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationDeleted waits for a Network Manager Transit Gateway Registration to be deleted.
+func TransitGatewayRegistrationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateAvailable, networkmanager.TransitGatewayRegistrationStateDeleting, networkmanager.TransitGatewayRegistrationStateFailed},
+		Target:  []string{},
+		Refresh: TransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,219 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DashboardByID returns the latest version of the QuickSight Dashboard corresponding to the specified ID.
+func DashboardByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string) (*quicksight.Dashboard, error) {
+	input := &quicksight.DescribeDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	}
+
+	output, err := conn.DescribeDashboardWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dashboard == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dashboard, nil
+}
+
+// DataSetByID returns the QuickSight Data Set corresponding to the specified ID.
+func DataSetByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSetID string) (*quicksight.DataSet, error) {
+	input := &quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	output, err := conn.DescribeDataSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataSet, nil
+}
+
+// DataSourceByID returns the QuickSight Data Source corresponding to the specified ID.
+func DataSourceByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSourceID string) (*quicksight.DataSource, error) {
+	input := &quicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	}
+
+	output, err := conn.DescribeDataSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataSource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataSource, nil
+}
+
+// GroupMembership returns the member of the QuickSight Group corresponding to the specified name.
+func GroupMembership(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace, groupName, memberName string) (*quicksight.GroupMember, error) {
+	input := &quicksight.ListGroupMembershipsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		GroupName:    aws.String(groupName),
+		Namespace:    aws.String(namespace),
+	}
+
+	var result *quicksight.GroupMember
+
+	err := listGroupMembershipsPages(ctx, conn, input, func(page *quicksight.ListGroupMembershipsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GroupMemberList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.MemberName) == memberName {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "group member not found",
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// IAMPolicyAssignmentByName returns the QuickSight IAM Policy Assignment corresponding to the specified name.
+func IAMPolicyAssignmentByName(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace, assignmentName string) (*quicksight.IAMPolicyAssignment, error) {
+	input := &quicksight.DescribeIAMPolicyAssignmentInput{
+		AssignmentName: aws.String(assignmentName),
+		AwsAccountId:   aws.String(awsAccountID),
+		Namespace:      aws.String(namespace),
+	}
+
+	output, err := conn.DescribeIAMPolicyAssignmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IAMPolicyAssignment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IAMPolicyAssignment, nil
+}
+
+// TemplateByID returns the latest version of the QuickSight Template corresponding to the specified ID.
+func TemplateByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string) (*quicksight.Template, error) {
+	input := &quicksight.DescribeTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}
+
+	output, err := conn.DescribeTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+// listGroupMembershipsPages pages through ListGroupMemberships, which has no SDK paginator.
+func listGroupMembershipsPages(ctx context.Context, conn *quicksight.QuickSight, input *quicksight.ListGroupMembershipsInput, fn func(*quicksight.ListGroupMembershipsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListGroupMembershipsWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package quicksight
+
+import (
+	"fmt"
+	"strings"
+)
+
+const dataSourceResourceIDSeparator = "/"
+
+func DataSourceCreateResourceID(awsAccountID, dataSourceID string) string {
+	parts := []string{awsAccountID, dataSourceID}
+	id := strings.Join(parts, dataSourceResourceIDSeparator)
+
+	return id
+}
+
+func DataSourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dataSourceResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sDATA_SOURCE_ID", id, dataSourceResourceIDSeparator)
+}
+
+const dataSetResourceIDSeparator = "/"
+
+func DataSetCreateResourceID(awsAccountID, dataSetID string) string {
+	parts := []string{awsAccountID, dataSetID}
+	id := strings.Join(parts, dataSetResourceIDSeparator)
+
+	return id
+}
+
+func DataSetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dataSetResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sDATA_SET_ID", id, dataSetResourceIDSeparator)
+}
+
+const templateResourceIDSeparator = "/"
+
+func TemplateCreateResourceID(awsAccountID, templateID string) string {
+	parts := []string{awsAccountID, templateID}
+	id := strings.Join(parts, templateResourceIDSeparator)
+
+	return id
+}
+
+func TemplateParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, templateResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sTEMPLATE_ID", id, templateResourceIDSeparator)
+}
+
+const dashboardResourceIDSeparator = "/"
+
+func DashboardCreateResourceID(awsAccountID, dashboardID string) string {
+	parts := []string{awsAccountID, dashboardID}
+	id := strings.Join(parts, dashboardResourceIDSeparator)
+
+	return id
+}
+
+func DashboardParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dashboardResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sDASHBOARD_ID", id, dashboardResourceIDSeparator)
+}
+
+const groupMembershipResourceIDSeparator = "/"
+
+func GroupMembershipCreateResourceID(awsAccountID, namespace, groupName, memberName string) string {
+	parts := []string{awsAccountID, namespace, groupName, memberName}
+	id := strings.Join(parts, groupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func GroupMembershipParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, groupMembershipResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sNAMESPACE%[2]sGROUP_NAME%[2]sMEMBER_NAME", id, groupMembershipResourceIDSeparator)
+}
+
+const iamPolicyAssignmentResourceIDSeparator = "/"
+
+func IAMPolicyAssignmentCreateResourceID(awsAccountID, namespace, assignmentName string) string {
+	parts := []string{awsAccountID, namespace, assignmentName}
+	id := strings.Join(parts, iamPolicyAssignmentResourceIDSeparator)
+
+	return id
+}
+
+func IAMPolicyAssignmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, iamPolicyAssignmentResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sNAMESPACE%[2]sASSIGNMENT_NAME", id, iamPolicyAssignmentResourceIDSeparator)
+}
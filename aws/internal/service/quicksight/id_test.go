@@ -0,0 +1,137 @@
+package quicksight_test
+
+import (
+	"testing"
+
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+)
+
+func TestDataSourceParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single part",
+			InputID:       "123456789012",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty second part",
+			InputID:       "123456789012/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "123456789012/example/extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfquicksight.DataSourceCreateResourceID("123456789012", "example"),
+			ExpectedPart0: "123456789012",
+			ExpectedPart1: "example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, err := tfquicksight.DataSourceParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+		})
+	}
+}
+
+func TestGroupMembershipParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputID       string
+		ExpectedError bool
+		ExpectedPart0 string
+		ExpectedPart1 string
+		ExpectedPart2 string
+		ExpectedPart3 string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "three parts",
+			InputID:       "123456789012/default/group",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty fourth part",
+			InputID:       "123456789012/default/group/",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "five parts",
+			InputID:       "123456789012/default/group/member/extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "valid ID",
+			InputID:       tfquicksight.GroupMembershipCreateResourceID("123456789012", "default", "group", "member"),
+			ExpectedPart0: "123456789012",
+			ExpectedPart1: "default",
+			ExpectedPart2: "group",
+			ExpectedPart3: "member",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPart0, gotPart1, gotPart2, gotPart3, err := tfquicksight.GroupMembershipParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPart0 != testCase.ExpectedPart0 {
+				t.Errorf("got part 0 %s, expected %s", gotPart0, testCase.ExpectedPart0)
+			}
+
+			if gotPart1 != testCase.ExpectedPart1 {
+				t.Errorf("got part 1 %s, expected %s", gotPart1, testCase.ExpectedPart1)
+			}
+
+			if gotPart2 != testCase.ExpectedPart2 {
+				t.Errorf("got part 2 %s, expected %s", gotPart2, testCase.ExpectedPart2)
+			}
+
+			if gotPart3 != testCase.ExpectedPart3 {
+				t.Errorf("got part 3 %s, expected %s", gotPart3, testCase.ExpectedPart3)
+			}
+		})
+	}
+}
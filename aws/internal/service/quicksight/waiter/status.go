@@ -0,0 +1,70 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// DashboardVersionStatus fetches the latest version of the QuickSight Dashboard and its status.
+func DashboardVersionStatus(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dashboard, err := finder.DashboardByID(ctx, conn, awsAccountID, dashboardID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if dashboard.Version == nil {
+			return nil, "", nil
+		}
+
+		return dashboard, aws.StringValue(dashboard.Version.Status), nil
+	}
+}
+
+// DataSourceStatus fetches the QuickSight Data Source and its status.
+func DataSourceStatus(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dataSource, err := finder.DataSourceByID(ctx, conn, awsAccountID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return dataSource, aws.StringValue(dataSource.Status), nil
+	}
+}
+
+// TemplateVersionStatus fetches the latest version of the QuickSight Template and its status.
+func TemplateVersionStatus(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		template, err := finder.TemplateByID(ctx, conn, awsAccountID, templateID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if template.Version == nil {
+			return nil, "", nil
+		}
+
+		return template, aws.StringValue(template.Version.Status), nil
+	}
+}
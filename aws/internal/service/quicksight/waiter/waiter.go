@@ -0,0 +1,121 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	DashboardVersionTimeout = 5 * time.Minute
+
+	DataSourceStatusTimeout = 5 * time.Minute
+
+	TemplateVersionTimeout = 5 * time.Minute
+)
+
+// DashboardVersionCreated waits for the latest QuickSight Dashboard version to be created or updated.
+func DashboardVersionCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, timeout time.Duration) (*quicksight.Dashboard, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress, quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful, quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: DashboardVersionStatus(ctx, conn, awsAccountID, dashboardID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Dashboard); ok {
+		if errs := output.Version.Errors; len(errs) > 0 {
+			var merr *multierror.Error
+
+			for _, apiObject := range errs {
+				merr = multierror.Append(merr, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Message)))
+			}
+
+			tfresource.SetLastError(err, merr.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DataSourceCreated waits for QuickSight Data Source to be created.
+func DataSourceCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSourceID string, timeout time.Duration) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: DataSourceStatus(ctx, conn, awsAccountID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		if v := output.ErrorInfo; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DataSourceUpdated waits for QuickSight Data Source to be updated.
+func DataSourceUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSourceID string, timeout time.Duration) (*quicksight.DataSource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: DataSourceStatus(ctx, conn, awsAccountID, dataSourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DataSource); ok {
+		if v := output.ErrorInfo; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TemplateVersionCreated waits for the latest QuickSight Template version to be created or updated.
+func TemplateVersionCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, timeout time.Duration) (*quicksight.Template, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress, quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful, quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: TemplateVersionStatus(ctx, conn, awsAccountID, templateID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Template); ok {
+		if errs := output.Version.Errors; len(errs) > 0 {
+			var merr *multierror.Error
+
+			for _, apiObject := range errs {
+				merr = multierror.Append(merr, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Message)))
+			}
+
+			tfresource.SetLastError(err, merr.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
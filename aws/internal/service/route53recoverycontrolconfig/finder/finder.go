@@ -0,0 +1,116 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ClusterByARN returns the Route 53 Recovery Control Config Cluster corresponding to the specified ARN.
+func ClusterByARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) (*r53rcc.Cluster, error) {
+	input := &r53rcc.DescribeClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+// ControlPanelByARN returns the Route 53 Recovery Control Config Control Panel corresponding to the specified ARN.
+func ControlPanelByARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) (*r53rcc.ControlPanel, error) {
+	input := &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeControlPanelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ControlPanel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ControlPanel, nil
+}
+
+// RoutingControlByARN returns the Route 53 Recovery Control Config Routing Control corresponding to the specified ARN.
+func RoutingControlByARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) (*r53rcc.RoutingControl, error) {
+	input := &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeRoutingControlWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RoutingControl == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RoutingControl, nil
+}
+
+// SafetyRuleByARN returns the Route 53 Recovery Control Config Safety Rule corresponding to the specified ARN.
+// Exactly one of the assertion rule or gating rule in the returned value is set.
+func SafetyRuleByARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) (*r53rcc.DescribeSafetyRuleOutput, error) {
+	input := &r53rcc.DescribeSafetyRuleInput{
+		SafetyRuleArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSafetyRuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || (output.AssertionRule == nil && output.GatingRule == nil) {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
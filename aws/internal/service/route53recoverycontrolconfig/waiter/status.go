@@ -0,0 +1,83 @@
+package waiter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ClusterStatus fetches the Route 53 Recovery Control Config Cluster and its status.
+func ClusterStatus(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ClusterByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ControlPanelStatus fetches the Route 53 Recovery Control Config Control Panel and its status.
+func ControlPanelStatus(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ControlPanelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// RoutingControlStatus fetches the Route 53 Recovery Control Config Routing Control and its status.
+func RoutingControlStatus(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.RoutingControlByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// SafetyRuleStatus fetches the Route 53 Recovery Control Config Safety Rule and its status.
+func SafetyRuleStatus(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.SafetyRuleByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.AssertionRule != nil {
+			return output, aws.StringValue(output.AssertionRule.Status), nil
+		}
+
+		return output, aws.StringValue(output.GatingRule.Status), nil
+	}
+}
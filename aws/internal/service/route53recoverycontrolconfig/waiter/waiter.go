@@ -0,0 +1,167 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ClusterCreatedTimeout = 10 * time.Minute
+	ClusterDeletedTimeout = 10 * time.Minute
+
+	ControlPanelCreatedTimeout = 10 * time.Minute
+	ControlPanelDeletedTimeout = 10 * time.Minute
+
+	RoutingControlCreatedTimeout = 10 * time.Minute
+	RoutingControlDeletedTimeout = 10 * time.Minute
+
+	SafetyRuleCreatedTimeout = 10 * time.Minute
+	SafetyRuleDeletedTimeout = 10 * time.Minute
+)
+
+// ClusterCreated waits for a Route 53 Recovery Control Config Cluster to be deployed.
+func ClusterCreated(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusPending},
+		Target:  []string{r53rcc.StatusDeployed},
+		Refresh: ClusterStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ClusterDeleted waits for a Route 53 Recovery Control Config Cluster to be deleted.
+func ClusterDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusDeployed, r53rcc.StatusPendingDeletion},
+		Target:  []string{},
+		Refresh: ClusterStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ControlPanelCreated waits for a Route 53 Recovery Control Config Control Panel to be deployed.
+func ControlPanelCreated(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.ControlPanel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusPending},
+		Target:  []string{r53rcc.StatusDeployed},
+		Refresh: ControlPanelStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.ControlPanel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ControlPanelDeleted waits for a Route 53 Recovery Control Config Control Panel to be deleted.
+func ControlPanelDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.ControlPanel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusDeployed, r53rcc.StatusPendingDeletion},
+		Target:  []string{},
+		Refresh: ControlPanelStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.ControlPanel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RoutingControlCreated waits for a Route 53 Recovery Control Config Routing Control to be deployed.
+func RoutingControlCreated(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.RoutingControl, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusPending},
+		Target:  []string{r53rcc.StatusDeployed},
+		Refresh: RoutingControlStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.RoutingControl); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RoutingControlDeleted waits for a Route 53 Recovery Control Config Routing Control to be deleted.
+func RoutingControlDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.RoutingControl, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusDeployed, r53rcc.StatusPendingDeletion},
+		Target:  []string{},
+		Refresh: RoutingControlStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.RoutingControl); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SafetyRuleCreated waits for a Route 53 Recovery Control Config Safety Rule to be deployed.
+func SafetyRuleCreated(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.DescribeSafetyRuleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusPending},
+		Target:  []string{r53rcc.StatusDeployed},
+		Refresh: SafetyRuleStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SafetyRuleDeleted waits for a Route 53 Recovery Control Config Safety Rule to be deleted.
+func SafetyRuleDeleted(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string, timeout time.Duration) (*r53rcc.DescribeSafetyRuleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{r53rcc.StatusDeployed, r53rcc.StatusPendingDeletion},
+		Target:  []string{},
+		Refresh: SafetyRuleStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
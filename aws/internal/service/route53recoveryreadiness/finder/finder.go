@@ -0,0 +1,115 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rr "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// CellByName returns the Route 53 Recovery Readiness Cell corresponding to the specified name.
+func CellByName(ctx context.Context, conn *r53rr.Route53RecoveryReadiness, name string) (*r53rr.GetCellOutput, error) {
+	input := &r53rr.GetCellInput{
+		CellName: aws.String(name),
+	}
+
+	output, err := conn.GetCellWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// ReadinessCheckByName returns the Route 53 Recovery Readiness Readiness Check corresponding to the specified name.
+func ReadinessCheckByName(ctx context.Context, conn *r53rr.Route53RecoveryReadiness, name string) (*r53rr.GetReadinessCheckOutput, error) {
+	input := &r53rr.GetReadinessCheckInput{
+		ReadinessCheckName: aws.String(name),
+	}
+
+	output, err := conn.GetReadinessCheckWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// RecoveryGroupByName returns the Route 53 Recovery Readiness Recovery Group corresponding to the specified name.
+func RecoveryGroupByName(ctx context.Context, conn *r53rr.Route53RecoveryReadiness, name string) (*r53rr.GetRecoveryGroupOutput, error) {
+	input := &r53rr.GetRecoveryGroupInput{
+		RecoveryGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetRecoveryGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// ResourceSetByName returns the Route 53 Recovery Readiness Resource Set corresponding to the specified name.
+func ResourceSetByName(ctx context.Context, conn *r53rr.Route53RecoveryReadiness, name string) (*r53rr.GetResourceSetOutput, error) {
+	input := &r53rr.GetResourceSetInput{
+		ResourceSetName: aws.String(name),
+	}
+
+	output, err := conn.GetResourceSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,71 @@
+package s3_test
+
+import (
+	"testing"
+
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+func TestParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                    string
+		InputID                     string
+		ExpectError                 bool
+		ExpectedBucket              string
+		ExpectedExpectedBucketOwner string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test,123456789012,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty expected bucket owner",
+			InputID:     "test,",
+			ExpectError: true,
+		},
+		{
+			TestName:       "valid ID without expected bucket owner",
+			InputID:        tfs3.CreateResourceID("test", ""),
+			ExpectedBucket: "test",
+		},
+		{
+			TestName:                    "valid ID with expected bucket owner",
+			InputID:                     tfs3.CreateResourceID("test", "123456789012"),
+			ExpectedBucket:              "test",
+			ExpectedExpectedBucketOwner: "123456789012",
+		},
+		{
+			TestName:       "valid ID with dots in bucket name",
+			InputID:        tfs3.CreateResourceID("example.com", ""),
+			ExpectedBucket: "example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotBucket, gotExpectedBucketOwner, err := tfs3.ParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotBucket != testCase.ExpectedBucket {
+				t.Errorf("got bucket %s, expected %s", gotBucket, testCase.ExpectedBucket)
+			}
+
+			if gotExpectedBucketOwner != testCase.ExpectedExpectedBucketOwner {
+				t.Errorf("got expected bucket owner %s, expected %s", gotExpectedBucketOwner, testCase.ExpectedExpectedBucketOwner)
+			}
+		})
+	}
+}
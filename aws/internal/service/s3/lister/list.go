@@ -0,0 +1,32 @@
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Custom S3 listing functions using similar formatting as other service generated code.
+// The listpages generator does not support the differing ContinuationToken/NextContinuationToken fields.
+
+func ListObjectsV2Pages(conn *s3.S3, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	return ListObjectsV2PagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListObjectsV2PagesWithContext(ctx context.Context, conn *s3.S3, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	for {
+		output, err := conn.ListObjectsV2WithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextContinuationToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.ContinuationToken = output.NextContinuationToken
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package finder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ReplicationSetByARN returns the Incident Manager Replication Set corresponding to the specified ARN.
+func ReplicationSetByARN(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string) (*ssmincidents.ReplicationSet, error) {
+	input := &ssmincidents.GetReplicationSetInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetReplicationSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReplicationSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReplicationSet, nil
+}
+
+// ResponsePlanByARN returns the Incident Manager Response Plan corresponding to the specified ARN.
+func ResponsePlanByARN(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string) (*ssmincidents.GetResponsePlanOutput, error) {
+	input := &ssmincidents.GetResponsePlanInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetResponsePlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ReplicationSetCreatedTimeout = 30 * time.Minute
+	ReplicationSetUpdatedTimeout = 30 * time.Minute
+	ReplicationSetDeletedTimeout = 30 * time.Minute
+)
+
+// ReplicationSetCreated waits for an Incident Manager Replication Set to return Active.
+func ReplicationSetCreated(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusCreating},
+		Target:  []string{ssmincidents.ReplicationSetStatusActive},
+		Refresh: ReplicationSetStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplicationSetUpdated waits for an Incident Manager Replication Set to return Active.
+func ReplicationSetUpdated(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusUpdating},
+		Target:  []string{ssmincidents.ReplicationSetStatusActive},
+		Refresh: ReplicationSetStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// ReplicationSetDeleted waits for an Incident Manager Replication Set to be deleted.
+func ReplicationSetDeleted(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmincidents.ReplicationSetStatusDeleting},
+		Target:  []string{},
+		Refresh: ReplicationSetStatus(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssmincidents.ReplicationSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,6 +44,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -73,6 +78,17 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"required_tags": requiredTagsSchema(),
+
+			"retry": retrySchema(),
+
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_TRACE_FILE", ""),
+				Description: descriptions["api_trace_file"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -129,6 +145,20 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -140,6 +170,40 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"HTTP_PROXY",
+					"HTTPS_PROXY",
+				}, ""),
+				Description:  descriptions["http_proxy"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT", ""),
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE", ""),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice([]string{ec2MetadataServiceEndpointModeIPv4, ec2MetadataServiceEndpointModeIPv6}, false),
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -211,6 +275,7 @@ func Provider() *schema.Provider {
 			"aws_billing_service_account":                    dataSourceAwsBillingServiceAccount(),
 			"aws_caller_identity":                            dataSourceAwsCallerIdentity(),
 			"aws_canonical_user_id":                          dataSourceAwsCanonicalUserId(),
+			"aws_ce_cost_category":                           dataSourceAwsCeCostCategory(),
 			"aws_cloudformation_export":                      dataSourceAwsCloudFormationExport(),
 			"aws_cloudformation_stack":                       dataSourceAwsCloudFormationStack(),
 			"aws_cloudformation_type":                        dataSourceAwsCloudFormationType(),
@@ -228,6 +293,13 @@ func Provider() *schema.Provider {
 			"aws_cognito_user_pools":                         dataSourceAwsCognitoUserPools(),
 			"aws_codecommit_repository":                      dataSourceAwsCodeCommitRepository(),
 			"aws_codestarconnections_connection":             dataSourceAwsCodeStarConnectionsConnection(),
+			"aws_connect_contact_flow":                       dataSourceAwsConnectContactFlow(),
+			"aws_connect_hours_of_operation":                 dataSourceAwsConnectHoursOfOperation(),
+			"aws_connect_instance":                           dataSourceAwsConnectInstance(),
+			"aws_connect_lambda_function_association":        dataSourceAwsConnectLambdaFunctionAssociation(),
+			"aws_connect_queue":                              dataSourceAwsConnectQueue(),
+			"aws_connect_routing_profile":                    dataSourceAwsConnectRoutingProfile(),
+			"aws_connect_security_profile":                   dataSourceAwsConnectSecurityProfile(),
 			"aws_cur_report_definition":                      dataSourceAwsCurReportDefinition(),
 			"aws_default_tags":                               dataSourceAwsDefaultTags(),
 			"aws_db_cluster_snapshot":                        dataSourceAwsDbClusterSnapshot(),
@@ -293,6 +365,7 @@ func Provider() *schema.Provider {
 			"aws_elb":                                        dataSourceAwsElb(),
 			"aws_elb_hosted_zone_id":                         dataSourceAwsElbHostedZoneId(),
 			"aws_elb_service_account":                        dataSourceAwsElbServiceAccount(),
+			"aws_emrcontainers_virtual_cluster":              dataSourceAwsEMRContainersVirtualCluster(),
 			"aws_globalaccelerator_accelerator":              dataSourceAwsGlobalAcceleratorAccelerator(),
 			"aws_glue_connection":                            dataSourceAwsGlueConnection(),
 			"aws_glue_data_catalog_encryption_settings":      dataSourceAwsGlueDataCatalogEncryptionSettings(),
@@ -344,6 +417,12 @@ func Provider() *schema.Provider {
 			"aws_lex_bot":                                    dataSourceAwsLexBot(),
 			"aws_lex_intent":                                 dataSourceAwsLexIntent(),
 			"aws_lex_slot_type":                              dataSourceAwsLexSlotType(),
+			"aws_memorydb_acl":                               dataSourceAwsMemoryDbAcl(),
+			"aws_memorydb_cluster":                           dataSourceAwsMemoryDbCluster(),
+			"aws_memorydb_parameter_group":                   dataSourceAwsMemoryDbParameterGroup(),
+			"aws_memorydb_snapshot":                          dataSourceAwsMemoryDbSnapshot(),
+			"aws_memorydb_subnet_group":                      dataSourceAwsMemoryDbSubnetGroup(),
+			"aws_memorydb_user":                              dataSourceAwsMemoryDbUser(),
 			"aws_mq_broker":                                  dataSourceAwsMqBroker(),
 			"aws_msk_cluster":                                dataSourceAwsMskCluster(),
 			"aws_msk_configuration":                          dataSourceAwsMskConfiguration(),
@@ -353,6 +432,8 @@ func Provider() *schema.Provider {
 			"aws_network_acls":                               dataSourceAwsNetworkAcls(),
 			"aws_network_interface":                          dataSourceAwsNetworkInterface(),
 			"aws_network_interfaces":                         dataSourceAwsNetworkInterfaces(),
+			"aws_networkmanager_global_network":              dataSourceAwsNetworkManagerGlobalNetwork(),
+			"aws_networkmanager_site":                        dataSourceAwsNetworkManagerSite(),
 			"aws_organizations_delegated_administrators":     dataSourceAwsOrganizationsDelegatedAdministrators(),
 			"aws_organizations_delegated_services":           dataSourceAwsOrganizationsDelegatedServices(),
 			"aws_organizations_organization":                 dataSourceAwsOrganizationsOrganization(),
@@ -398,6 +479,8 @@ func Provider() *schema.Provider {
 			"aws_servicecatalog_portfolio_constraints":       dataSourceAwsServiceCatalogPortfolioConstraints(),
 			"aws_servicecatalog_portfolio":                   dataSourceAwsServiceCatalogPortfolio(),
 			"aws_servicecatalog_product":                     dataSourceAwsServiceCatalogProduct(),
+			"aws_servicecatalogappregistry_application":      dataSourceAwsServiceCatalogAppRegistryApplication(),
+			"aws_servicecatalogappregistry_attribute_group":  dataSourceAwsServiceCatalogAppRegistryAttributeGroup(),
 			"aws_servicequotas_service":                      dataSourceAwsServiceQuotasService(),
 			"aws_servicequotas_service_quota":                dataSourceAwsServiceQuotasServiceQuota(),
 			"aws_service_discovery_dns_namespace":            dataSourceServiceDiscoveryDnsNamespace(),
@@ -526,6 +609,12 @@ func Provider() *schema.Provider {
 			"aws_apprunner_connection":                                resourceAwsAppRunnerConnection(),
 			"aws_apprunner_custom_domain_association":                 resourceAwsAppRunnerCustomDomainAssociation(),
 			"aws_apprunner_service":                                   resourceAwsAppRunnerService(),
+			"aws_appstream_fleet":                                     resourceAwsAppStreamFleet(),
+			"aws_appstream_fleet_stack_association":                   resourceAwsAppStreamFleetStackAssociation(),
+			"aws_appstream_image_builder":                             resourceAwsAppStreamImageBuilder(),
+			"aws_appstream_stack":                                     resourceAwsAppStreamStack(),
+			"aws_appstream_user":                                      resourceAwsAppStreamUser(),
+			"aws_appstream_user_stack_association":                    resourceAwsAppStreamUserStackAssociation(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
@@ -550,6 +639,9 @@ func Provider() *schema.Provider {
 			"aws_backup_vault_policy":                                 resourceAwsBackupVaultPolicy(),
 			"aws_budgets_budget":                                      resourceAwsBudgetsBudget(),
 			"aws_budgets_budget_action":                               resourceAwsBudgetsBudgetAction(),
+			"aws_ce_anomaly_monitor":                                  resourceAwsCeAnomalyMonitor(),
+			"aws_ce_anomaly_subscription":                             resourceAwsCeAnomalySubscription(),
+			"aws_ce_cost_category":                                    resourceAwsCeCostCategory(),
 			"aws_chime_voice_connector":                               resourceAwsChimeVoiceConnector(),
 			"aws_chime_voice_connector_group":                         resourceAwsChimeVoiceConnectorGroup(),
 			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
@@ -627,6 +719,12 @@ func Provider() *schema.Provider {
 			"aws_codestarconnections_connection":                      resourceAwsCodeStarConnectionsConnection(),
 			"aws_codestarconnections_host":                            resourceAwsCodeStarConnectionsHost(),
 			"aws_codestarnotifications_notification_rule":             resourceAwsCodeStarNotificationsNotificationRule(),
+			"aws_connect_contact_flow":                                resourceAwsConnectContactFlow(),
+			"aws_connect_hours_of_operation":                          resourceAwsConnectHoursOfOperation(),
+			"aws_connect_instance":                                    resourceAwsConnectInstance(),
+			"aws_connect_lambda_function_association":                 resourceAwsConnectLambdaFunctionAssociation(),
+			"aws_connect_queue":                                       resourceAwsConnectQueue(),
+			"aws_connect_routing_profile":                             resourceAwsConnectRoutingProfile(),
 			"aws_cur_report_definition":                               resourceAwsCurReportDefinition(),
 			"aws_customer_gateway":                                    resourceAwsCustomerGateway(),
 			"aws_datapipeline_pipeline":                               resourceAwsDataPipelinePipeline(),
@@ -653,6 +751,9 @@ func Provider() *schema.Provider {
 			"aws_db_security_group":                                   resourceAwsDbSecurityGroup(),
 			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
+			"aws_detective_graph":                                     resourceAwsDetectiveGraph(),
+			"aws_detective_invitation_accepter":                       resourceAwsDetectiveInvitationAccepter(),
+			"aws_detective_member":                                    resourceAwsDetectiveMember(),
 			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
 			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
 			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
@@ -768,6 +869,7 @@ func Provider() *schema.Provider {
 			"aws_emr_instance_fleet":                                  resourceAwsEMRInstanceFleet(),
 			"aws_emr_managed_scaling_policy":                          resourceAwsEMRManagedScalingPolicy(),
 			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_emrcontainers_virtual_cluster":                       resourceAwsEMRContainersVirtualCluster(),
 			"aws_flow_log":                                            resourceAwsFlowLog(),
 			"aws_fsx_backup":                                          resourceAwsFsxBackup(),
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
@@ -889,6 +991,12 @@ func Provider() *schema.Provider {
 			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
 			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
 			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
+			"aws_location_geofence_collection":                        resourceAwsLocationGeofenceCollection(),
+			"aws_location_map":                                        resourceAwsLocationMap(),
+			"aws_location_place_index":                                resourceAwsLocationPlaceIndex(),
+			"aws_location_route_calculator":                           resourceAwsLocationRouteCalculator(),
+			"aws_location_tracker":                                    resourceAwsLocationTracker(),
+			"aws_location_tracker_association":                        resourceAwsLocationTrackerAssociation(),
 			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
 			"aws_load_balancer_policy":                                resourceAwsLoadBalancerPolicy(),
 			"aws_load_balancer_backend_server_policy":                 resourceAwsLoadBalancerBackendServerPolicies(),
@@ -904,6 +1012,12 @@ func Provider() *schema.Provider {
 			"aws_macie_member_account_association":                    resourceAwsMacieMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":                         resourceAwsMacieS3BucketAssociation(),
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
+			"aws_memorydb_acl":                                        resourceAwsMemoryDbAcl(),
+			"aws_memorydb_cluster":                                    resourceAwsMemoryDbCluster(),
+			"aws_memorydb_parameter_group":                            resourceAwsMemoryDbParameterGroup(),
+			"aws_memorydb_snapshot":                                   resourceAwsMemoryDbSnapshot(),
+			"aws_memorydb_subnet_group":                               resourceAwsMemoryDbSubnetGroup(),
+			"aws_memorydb_user":                                       resourceAwsMemoryDbUser(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
 			"aws_media_convert_queue":                                 resourceAwsMediaConvertQueue(),
@@ -933,6 +1047,13 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_logging_configuration":               resourceAwsNetworkFirewallLoggingConfiguration(),
 			"aws_networkfirewall_resource_policy":                     resourceAwsNetworkFirewallResourcePolicy(),
 			"aws_networkfirewall_rule_group":                          resourceAwsNetworkFirewallRuleGroup(),
+			"aws_networkmanager_customer_gateway_association":         resourceAwsNetworkManagerCustomerGatewayAssociation(),
+			"aws_networkmanager_device":                               resourceAwsNetworkManagerDevice(),
+			"aws_networkmanager_global_network":                       resourceAwsNetworkManagerGlobalNetwork(),
+			"aws_networkmanager_link":                                 resourceAwsNetworkManagerLink(),
+			"aws_networkmanager_link_association":                     resourceAwsNetworkManagerLinkAssociation(),
+			"aws_networkmanager_site":                                 resourceAwsNetworkManagerSite(),
+			"aws_networkmanager_transit_gateway_registration":         resourceAwsNetworkManagerTransitGatewayRegistration(),
 			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
 			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
 			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
@@ -959,7 +1080,13 @@ func Provider() *schema.Provider {
 			"aws_prometheus_workspace":                                resourceAwsPrometheusWorkspace(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
 			"aws_qldb_ledger":                                         resourceAwsQLDBLedger(),
+			"aws_quicksight_dashboard":                                resourceAwsQuickSightDashboard(),
+			"aws_quicksight_data_set":                                 resourceAwsQuickSightDataSet(),
+			"aws_quicksight_data_source":                              resourceAwsQuickSightDataSource(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
+			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
+			"aws_quicksight_iam_policy_assignment":                    resourceAwsQuickSightIAMPolicyAssignment(),
+			"aws_quicksight_template":                                 resourceAwsQuickSightTemplate(),
 			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
@@ -998,6 +1125,15 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_firewall_rule_group_association":    resourceAwsRoute53ResolverFirewallRuleGroupAssociation(),
 			"aws_route53_resolver_query_log_config":                   resourceAwsRoute53ResolverQueryLogConfig(),
 			"aws_route53_resolver_query_log_config_association":       resourceAwsRoute53ResolverQueryLogConfigAssociation(),
+			"aws_route53domains_registered_domain":                    resourceAwsRoute53DomainsRegisteredDomain(),
+			"aws_route53recoverycontrolconfig_cluster":                resourceAwsRoute53RecoveryControlConfigCluster(),
+			"aws_route53recoverycontrolconfig_control_panel":          resourceAwsRoute53RecoveryControlConfigControlPanel(),
+			"aws_route53recoverycontrolconfig_routing_control":        resourceAwsRoute53RecoveryControlConfigRoutingControl(),
+			"aws_route53recoverycontrolconfig_safety_rule":            resourceAwsRoute53RecoveryControlConfigSafetyRule(),
+			"aws_route53recoveryreadiness_cell":                       resourceAwsRoute53RecoveryReadinessCell(),
+			"aws_route53recoveryreadiness_readiness_check":            resourceAwsRoute53RecoveryReadinessReadinessCheck(),
+			"aws_route53recoveryreadiness_recovery_group":             resourceAwsRoute53RecoveryReadinessRecoveryGroup(),
+			"aws_route53recoveryreadiness_resource_set":               resourceAwsRoute53RecoveryReadinessResourceSet(),
 			"aws_route53_resolver_rule_association":                   resourceAwsRoute53ResolverRuleAssociation(),
 			"aws_route53_resolver_rule":                               resourceAwsRoute53ResolverRule(),
 			"aws_route":                                               resourceAwsRoute(),
@@ -1054,6 +1190,17 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_accelerate_configuration":                  resourceAwsS3BucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_object_lock_configuration":                 resourceAwsS3BucketObjectLockConfiguration(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":             resourceAwsS3BucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_object_copy":                                      resourceAwsS3ObjectCopy(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
@@ -1107,6 +1254,11 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssmcontacts_contact":                                 resourceAwsSsmContactsContact(),
+			"aws_ssmcontacts_contact_channel":                         resourceAwsSsmContactsContactChannel(),
+			"aws_ssmcontacts_plan":                                    resourceAwsSsmContactsPlan(),
+			"aws_ssmincidents_replication_set":                        resourceAwsSsmIncidentsReplicationSet(),
+			"aws_ssmincidents_response_plan":                          resourceAwsSsmIncidentsResponsePlan(),
 			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
 			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
 			"aws_ssoadmin_permission_set":                             resourceAwsSsoAdminPermissionSet(),
@@ -1234,6 +1386,11 @@ func Provider() *schema.Provider {
 			"aws_lb_target_group":             resourceAwsLbTargetGroup(),
 			"aws_alb_target_group_attachment": resourceAwsLbTargetGroupAttachment(),
 			"aws_lb_target_group_attachment":  resourceAwsLbTargetGroupAttachment(),
+
+			"aws_servicecatalogappregistry_application":                 resourceAwsServiceCatalogAppRegistryApplication(),
+			"aws_servicecatalogappregistry_attribute_group":             resourceAwsServiceCatalogAppRegistryAttributeGroup(),
+			"aws_servicecatalogappregistry_attribute_group_association": resourceAwsServiceCatalogAppRegistryAttributeGroupAssociation(),
+			"aws_servicecatalogappregistry_resource_association":        resourceAwsServiceCatalogAppRegistryResourceAssociation(),
 		},
 	}
 
@@ -1242,6 +1399,18 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	for resourceType, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok {
+			if r.CustomizeDiff != nil {
+				r.CustomizeDiff = resourceTypeCustomizeDiff(resourceType, r.CustomizeDiff)
+			}
+
+			continue
+		}
+
+		r.CustomizeDiff = warnUntaggableResourceDiff(resourceType, r.CustomizeDiff)
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1282,11 +1451,27 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"api_trace_file": "The path to a file to append a JSON trace of each AWS API request to.\n" +
+			"Can also be configured using the `TF_AWS_API_TRACE_FILE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API.\n" +
+			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates.\n" +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use.\n" +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint.\n" +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the\n" +
+			"`AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1318,6 +1503,7 @@ func init() {
 		"applicationautoscaling",
 		"applicationinsights",
 		"appmesh",
+		"appregistry",
 		"apprunner",
 		"appstream",
 		"appsync",
@@ -1328,6 +1514,7 @@ func init() {
 		"backup",
 		"batch",
 		"budgets",
+		"ce",
 		"chime",
 		"cloud9",
 		"cloudformation",
@@ -1413,6 +1600,7 @@ func init() {
 		"mediapackage",
 		"mediastore",
 		"mediastoredata",
+		"memorydb",
 		"mq",
 		"mwaa",
 		"neptune",
@@ -1454,6 +1642,8 @@ func init() {
 		"sns",
 		"sqs",
 		"ssm",
+		"ssmcontacts",
+		"ssmincidents",
 		"ssoadmin",
 		"stepfunctions",
 		"storagegateway",
@@ -1474,24 +1664,30 @@ func init() {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		terraformVersion:        terraformVersion,
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		APITraceFile:                   d.Get("api_trace_file").(string),
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		RequiredTagsConfig:             expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		terraformVersion:               terraformVersion,
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -1558,6 +1754,91 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("retry").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["max_backoff"].(string); ok && v != "" {
+			maxBackoff, err := time.ParseDuration(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing retry max_backoff (%s): %w", v, err)
+			}
+
+			config.RetryMaxBackoff = maxBackoff
+		}
+
+		if v, ok := m["mode"].(string); ok && v != "" {
+			config.RetryMode = v
+		}
+
+		if rateLimitSet, ok := m["rate_limit"].(*schema.Set); ok && rateLimitSet.Len() > 0 {
+			config.RetryRateLimits = make(map[string]RetryRateLimit)
+
+			for _, rateLimitRaw := range rateLimitSet.List() {
+				rateLimit, ok := rateLimitRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				config.RetryRateLimits[rateLimit["service"].(string)] = RetryRateLimit{
+					Burst:             rateLimit["burst"].(int),
+					RequestsPerSecond: rateLimit["requests_per_second"].(float64),
+				}
+			}
+		}
+
+		log.Printf("[INFO] retry configuration set: (Mode: %q, MaxBackoff: %s)", config.RetryMode, config.RetryMaxBackoff)
+	}
+
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration"].(string); ok && v != "" {
+			duration, err := time.ParseDuration(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing assume_role_with_web_identity duration (%s): %w", v, err)
+			}
+
+			config.AssumeRoleWithWebIdentityDuration = duration
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1645,6 +1926,197 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"assume_role"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Duration of the web identity role session, e.g. `1h` or `30m`. Valid values are between 15 minutes and 12 hours.",
+					ValidateFunc: validateAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateArn,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity token prior to making API calls.",
+					ValidateFunc: validateArn,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token. The file is read each time credentials are refreshed.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
+func validateAssumeRoleDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < 15*time.Minute || duration > 12*time.Hour {
+		errors = append(errors, fmt.Errorf("%q must be between 15 minutes (15m) and 12 hours (12h), got: %s", k, duration))
+	}
+
+	return
+}
+
+func requiredTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to require resource tags across all resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enforcement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      keyvaluetags.RequiredTagsEnforcementError,
+					Description:  "Whether missing or invalid required tags fail the plan (`error`) or are logged (`warn`).",
+					ValidateFunc: validation.StringInSlice([]string{keyvaluetags.RequiredTagsEnforcementError, keyvaluetags.RequiredTagsEnforcementWarn}, false),
+				},
+				"exclude_resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: "Resource types, e.g. `aws_vpc`, to which required tags do not apply.",
+				},
+				"tag": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "Required resource tags.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_value_regexes": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+								Description: "Regular expressions, any of which the tag value must match.",
+							},
+							"key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Required resource tag key.",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to retry and rate limit AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Maximum delay between retries of a request, e.g. `20s` or `1m`.",
+					ValidateFunc: validateRetryMaxBackoff,
+				},
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      retryModeStandard,
+					Description:  "Retry mode. Valid values are `standard` and `adaptive`, which also limits the request rate to a service after it throttles requests.",
+					ValidateFunc: validation.StringInSlice([]string{retryModeAdaptive, retryModeStandard}, false),
+				},
+				"rate_limit": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Client-side limits on the rate of requests to individual services.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								Description:  "Number of requests that can be sent at once.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								Description:  "Sustained number of requests per second.",
+								ValidateFunc: validation.FloatAtLeast(0.1),
+							},
+							"service": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Service to limit, e.g. `route53`, `organizations` or `iam`.",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateRetryMaxBackoff(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero, got: %s", k, duration))
+	}
+
+	return
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1696,9 +2168,63 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = keyvaluetags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		ignoreConfig.KeyRegexes = expandProviderTagRegexes(v.List())
+	}
+
+	if v, ok := m["value_regexes"].(*schema.Set); ok {
+		ignoreConfig.ValueRegexes = expandProviderTagRegexes(v.List())
+	}
+
 	return ignoreConfig
 }
 
+func expandProviderRequiredTags(l []interface{}) *keyvaluetags.RequiredConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	requiredConfig := &keyvaluetags.RequiredConfig{
+		Tags: make(map[string][]*regexp.Regexp),
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["enforcement"].(string); ok {
+		requiredConfig.Enforcement = v
+	}
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			requiredConfig.ExcludeResourceTypes = append(requiredConfig.ExcludeResourceTypes, v.(string))
+		}
+	}
+
+	if v, ok := m["tag"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requiredConfig.Tags[tfMap["key"].(string)] = expandProviderTagRegexes(tfMap["allowed_value_regexes"].([]interface{}))
+		}
+	}
+
+	return requiredConfig
+}
+
+// expandProviderTagRegexes compiles regular expressions validated by the provider schema.
+func expandProviderTagRegexes(l []interface{}) []*regexp.Regexp {
+	var regexes []*regexp.Regexp
+
+	for _, v := range l {
+		regexes = append(regexes, regexp.MustCompile(v.(string)))
+	}
+
+	return regexes
+}
+
 // ReverseDns switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDns(hostname string) string {
 	parts := strings.Split(hostname, ".")
@@ -0,0 +1,635 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAppStreamFleetCreate,
+		ReadContext:   resourceAwsAppStreamFleetRead,
+		UpdateContext: resourceAwsAppStreamFleetUpdate,
+		DeleteContext: resourceAwsAppStreamFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.FleetStateTimeout),
+			Update: schema.DefaultTimeout(waiter.FleetStateTimeout),
+			Delete: schema.DefaultTimeout(waiter.FleetStateTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_instances": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"in_use": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 360000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"domain_join_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"organizational_unit_distinguished_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2000),
+						},
+					},
+				},
+			},
+			"enable_default_internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"fleet_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.FleetType_Values(), false),
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"idle_disconnect_timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"image_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+				ValidateFunc: validateArn,
+			},
+			"image_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_arn", "image_name"},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"max_user_duration_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(600, 360000),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`), "must begin with an alphanumeric character and contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_view": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(appstream.StreamView_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appstream.CreateFleetInput{
+		ComputeCapacity: expandAppStreamComputeCapacity(d.Get("compute_capacity").([]interface{})),
+		InstanceType:    aws.String(d.Get("instance_type").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_seconds"); ok {
+		input.DisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_join_info"); ok {
+		input.DomainJoinInfo = expandAppStreamDomainJoinInfo(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_default_internet_access"); ok {
+		input.EnableDefaultInternetAccess = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("fleet_type"); ok {
+		input.FleetType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.IamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idle_disconnect_timeout_in_seconds"); ok {
+		input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("image_arn"); ok {
+		input.ImageArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_name"); ok {
+		input.ImageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
+		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("stream_view"); ok {
+		input.StreamView = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandAppStreamVpcConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppstreamTags()
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet: %s", input)
+	// IAM role propagation can cause the first attempt to fail.
+	_, err := tfresource.RetryWhenAwsErrCodeEqualsContext(ctx, iamwaiter.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFleetWithContext(ctx, input)
+		},
+		appstream.ErrCodeInvalidRoleException)
+
+	if err != nil {
+		return diag.Errorf("error creating AppStream Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Starting AppStream Fleet (%s)", d.Id())
+	_, err = conn.StartFleetWithContext(ctx, &appstream.StartFleetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return diag.Errorf("error starting AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.FleetStateRunning(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for AppStream Fleet (%s) to be running: %s", d.Id(), err)
+	}
+
+	return resourceAwsAppStreamFleetRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	fleet, err := finder.FleetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", fleet.Arn)
+
+	if err := d.Set("compute_capacity", flattenAppStreamComputeCapacityStatus(fleet.ComputeCapacityStatus)); err != nil {
+		return diag.Errorf("error setting compute_capacity: %s", err)
+	}
+
+	d.Set("created_time", aws.TimeValue(fleet.CreatedTime).Format(time.RFC3339))
+	d.Set("description", fleet.Description)
+	d.Set("disconnect_timeout_in_seconds", fleet.DisconnectTimeoutInSeconds)
+	d.Set("display_name", fleet.DisplayName)
+
+	if err := d.Set("domain_join_info", flattenAppStreamDomainJoinInfo(fleet.DomainJoinInfo)); err != nil {
+		return diag.Errorf("error setting domain_join_info: %s", err)
+	}
+
+	d.Set("enable_default_internet_access", fleet.EnableDefaultInternetAccess)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("iam_role_arn", fleet.IamRoleArn)
+	d.Set("idle_disconnect_timeout_in_seconds", fleet.IdleDisconnectTimeoutInSeconds)
+	d.Set("image_arn", fleet.ImageArn)
+	d.Set("image_name", fleet.ImageName)
+	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
+	d.Set("name", fleet.Name)
+	d.Set("state", fleet.State)
+	d.Set("stream_view", fleet.StreamView)
+
+	if err := d.Set("vpc_config", flattenAppStreamVpcConfig(fleet.VpcConfig)); err != nil {
+		return diag.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppstreamListTags(conn, aws.StringValue(fleet.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appstream.UpdateFleetInput{
+			Name: aws.String(d.Id()),
+		}
+
+		// These attributes can only be changed while the fleet is stopped.
+		stopRequired := d.HasChanges("domain_join_info", "instance_type", "vpc_config")
+
+		if d.HasChange("compute_capacity") {
+			input.ComputeCapacity = expandAppStreamComputeCapacity(d.Get("compute_capacity").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("disconnect_timeout_in_seconds") {
+			input.DisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("domain_join_info") {
+			input.DomainJoinInfo = expandAppStreamDomainJoinInfo(d.Get("domain_join_info").([]interface{}))
+		}
+
+		if d.HasChange("enable_default_internet_access") {
+			input.EnableDefaultInternetAccess = aws.Bool(d.Get("enable_default_internet_access").(bool))
+		}
+
+		if d.HasChange("iam_role_arn") {
+			input.IamRoleArn = aws.String(d.Get("iam_role_arn").(string))
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_seconds") {
+			input.IdleDisconnectTimeoutInSeconds = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_seconds").(int)))
+		}
+
+		if d.HasChange("image_arn") {
+			input.ImageArn = aws.String(d.Get("image_arn").(string))
+		}
+
+		if d.HasChange("image_name") {
+			input.ImageName = aws.String(d.Get("image_name").(string))
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("max_user_duration_in_seconds") {
+			input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
+		}
+
+		if d.HasChange("stream_view") {
+			input.StreamView = aws.String(d.Get("stream_view").(string))
+		}
+
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandAppStreamVpcConfig(d.Get("vpc_config").([]interface{}))
+		}
+
+		if stopRequired {
+			if err := appStreamFleetStop(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating AppStream Fleet (%s): %s", d.Id(), input)
+		_, err := conn.UpdateFleetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating AppStream Fleet (%s): %s", d.Id(), err)
+		}
+
+		if stopRequired {
+			if err := appStreamFleetStart(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppstreamUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating AppStream Fleet (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamFleetRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	if err := appStreamFleetStop(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet: (%s)", d.Id())
+	_, err := conn.DeleteFleetWithContext(ctx, &appstream.DeleteFleetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppStream Fleet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// appStreamFleetStart starts the fleet, if not already running, and waits for it to be running.
+func appStreamFleetStart(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting AppStream Fleet (%s)", name)
+	_, err := conn.StartFleetWithContext(ctx, &appstream.StartFleetInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting AppStream Fleet (%s): %w", name, err)
+	}
+
+	if _, err := waiter.FleetStateRunning(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to be running: %w", name, err)
+	}
+
+	return nil
+}
+
+// appStreamFleetStop stops the fleet, if not already stopped, and waits for it to be stopped.
+func appStreamFleetStop(ctx context.Context, conn *appstream.AppStream, name string, timeout time.Duration) error {
+	fleet, err := finder.FleetByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(fleet.State) == appstream.FleetStateStopped {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Stopping AppStream Fleet (%s)", name)
+	_, err = conn.StopFleetWithContext(ctx, &appstream.StopFleetInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping AppStream Fleet (%s): %w", name, err)
+	}
+
+	if _, err := waiter.FleetStateStopped(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("error waiting for AppStream Fleet (%s) to be stopped: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandAppStreamComputeCapacity(tfList []interface{}) *appstream.ComputeCapacity {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appstream.ComputeCapacity{}
+
+	if v, ok := tfMap["desired_instances"].(int); ok {
+		apiObject.DesiredInstances = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamComputeCapacityStatus(apiObject *appstream.ComputeCapacityStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"available":         aws.Int64Value(apiObject.Available),
+		"desired_instances": aws.Int64Value(apiObject.Desired),
+		"in_use":            aws.Int64Value(apiObject.InUse),
+		"running":           aws.Int64Value(apiObject.Running),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandAppStreamDomainJoinInfo(tfList []interface{}) *appstream.DomainJoinInfo {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appstream.DomainJoinInfo{}
+
+	if v, ok := tfMap["directory_name"].(string); ok && v != "" {
+		apiObject.DirectoryName = aws.String(v)
+	}
+
+	if v, ok := tfMap["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		apiObject.OrganizationalUnitDistinguishedName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamDomainJoinInfo(apiObject *appstream.DomainJoinInfo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"directory_name":                         aws.StringValue(apiObject.DirectoryName),
+		"organizational_unit_distinguished_name": aws.StringValue(apiObject.OrganizationalUnitDistinguishedName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandAppStreamVpcConfig(tfList []interface{}) *appstream.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appstream.VpcConfig{}
+
+	if v, ok := tfMap["security_group_ids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecurityGroupIds = expandStringList(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SubnetIds = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenAppStreamVpcConfig(apiObject *appstream.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamFleetStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAppStreamFleetStackAssociationCreate,
+		ReadContext:   resourceAwsAppStreamFleetStackAssociationRead,
+		DeleteContext: resourceAwsAppStreamFleetStackAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fleet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamFleetStackAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName := d.Get("fleet_name").(string)
+	stackName := d.Get("stack_name").(string)
+	id := tfappstream.FleetStackAssociationCreateResourceID(fleetName, stackName)
+	input := &appstream.AssociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	}
+
+	log.Printf("[DEBUG] Creating AppStream Fleet Stack Association: %s", input)
+	_, err := conn.AssociateFleetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating AppStream Fleet Stack Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAppStreamFleetStackAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamFleetStackAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = finder.FleetStackAssociation(ctx, conn, fleetName, stackName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Fleet Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppStream Fleet Stack Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("fleet_name", fleetName)
+	d.Set("stack_name", stackName)
+
+	return nil
+}
+
+func resourceAwsAppStreamFleetStackAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream Fleet Stack Association: (%s)", d.Id())
+	_, err = conn.DisassociateFleetWithContext(ctx, &appstream.DisassociateFleetInput{
+		FleetName: aws.String(fleetName),
+		StackName: aws.String(stackName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppStream Fleet Stack Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_fleet_stack_association", &resource.Sweeper{
+		Name: "aws_appstream_fleet_stack_association",
+		F:    testSweepAppStreamFleetStackAssociations,
+	})
+}
+
+func testSweepAppStreamFleetStackAssociations(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).appstreamconn
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &appstream.DescribeFleetsInput{}
+
+	for {
+		output, err := conn.DescribeFleets(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing AppStream Fleets: %w", err))
+			break
+		}
+
+		for _, fleet := range output.Fleets {
+			fleetName := aws.StringValue(fleet.Name)
+			input := &appstream.ListAssociatedStacksInput{
+				FleetName: aws.String(fleetName),
+			}
+
+			for {
+				output, err := conn.ListAssociatedStacks(input)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error listing AppStream Stacks associated with Fleet (%s): %w", fleetName, err))
+					break
+				}
+
+				for _, stackName := range output.Names {
+					id := tfappstream.FleetStackAssociationCreateResourceID(fleetName, aws.StringValue(stackName))
+
+					log.Printf("[INFO] Deleting AppStream Fleet Stack Association (%s)", id)
+					r := resourceAwsAppStreamFleetStackAssociation()
+					d := r.Data(nil)
+					d.SetId(id)
+
+					sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+				}
+
+				if aws.StringValue(output.NextToken) == "" {
+					break
+				}
+
+				input.NextToken = output.NextToken
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppStream Fleet Stack Associations for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping AppStream Fleet Stack Associations sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamFleetStackAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_name", "aws_appstream_fleet.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamFleetStackAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_fleet_stack_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamFleetStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamFleetStackAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamFleetStackAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamFleetStackAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_fleet_stack_association" {
+			continue
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		err = finder.FleetStackAssociation(context.Background(), conn, fleetName, stackName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Fleet Stack Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamFleetStackAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Fleet Stack Association ID is set")
+		}
+
+		fleetName, stackName, err := tfappstream.FleetStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		return finder.FleetStackAssociation(context.Background(), conn, fleetName, stackName)
+	}
+}
+
+func testAccAWSAppStreamFleetStackAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type = "stream.standard.small"
+
+  compute_capacity {
+    desired_instances = 1
+  }
+}
+
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_fleet_stack_association" "test" {
+  fleet_name = aws_appstream_fleet.test.name
+  stack_name = aws_appstream_stack.test.name
+}
+`, rName)
+}
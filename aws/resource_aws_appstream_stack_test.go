@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
+		Name: "aws_appstream_stack",
+		F:    testSweepAppStreamStacks,
+		Dependencies: []string{
+			"aws_appstream_fleet_stack_association",
+		},
+	})
+}
+
+func testSweepAppStreamStacks(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).appstreamconn
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &appstream.DescribeStacksInput{}
+
+	for {
+		output, err := conn.DescribeStacks(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing AppStream Stacks: %w", err))
+			break
+		}
+
+		for _, stack := range output.Stacks {
+			id := aws.StringValue(stack.Name)
+
+			log.Printf("[INFO] Deleting AppStream Stack (%s)", id)
+			r := resourceAwsAppStreamStack()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppStream Stacks for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping AppStream Stacks sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSAppStreamStack_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "appstream", "stack/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_complete(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_settings.0.settings_group", "SettingsGroup"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "feedback_url", "https://example.com/feedback"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_url", "https://example.com/redirect"),
+					resource.TestCheckResourceAttr(resourceName, "storage_connectors.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "storage_connectors.*", map[string]string{
+						"connector_type": appstream.StorageConnectorTypeHomefolders,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": appstream.PermissionEnabled,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyToLocalDevice,
+						"permission": appstream.PermissionDisabled,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigComplete(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamStack_tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSAppStreamStackConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamStackExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_stack" {
+			continue
+		}
+
+		_, err := finder.StackByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Stack %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamStackExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Stack ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err := finder.StackByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSAppStreamStackConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAppStreamStackConfigComplete(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  display_name = %[1]q
+  feedback_url = "https://example.com/feedback"
+  redirect_url = "https://example.com/redirect"
+
+  application_settings {
+    enabled        = true
+    settings_group = "SettingsGroup"
+  }
+
+  storage_connectors {
+    connector_type = "HOMEFOLDERS"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_FROM_LOCAL_DEVICE"
+    permission = "ENABLED"
+  }
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_TO_LOCAL_DEVICE"
+    permission = "DISABLED"
+  }
+}
+`, rName, description)
+}
+
+func testAccAWSAppStreamStackConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSAppStreamStackConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
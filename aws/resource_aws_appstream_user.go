@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAppStreamUserCreate,
+		ReadContext:   resourceAwsAppStreamUserRead,
+		UpdateContext: resourceAwsAppStreamUserUpdate,
+		DeleteContext: resourceAwsAppStreamUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AuthenticationType_Values(), false),
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"first_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"last_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName := d.Get("user_name").(string)
+	authenticationType := d.Get("authentication_type").(string)
+	id := tfappstream.UserCreateResourceID(userName, authenticationType)
+	input := &appstream.CreateUserInput{
+		AuthenticationType: aws.String(authenticationType),
+		UserName:           aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("first_name"); ok {
+		input.FirstName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("last_name"); ok {
+		input.LastName = aws.String(v.(string))
+	}
+
+	if !d.Get("send_email_notification").(bool) {
+		input.MessageAction = aws.String(appstream.MessageActionSuppress)
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User: %s", input)
+	_, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating AppStream User (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if !d.Get("enabled").(bool) {
+		_, err := conn.DisableUserWithContext(ctx, &appstream.DisableUserInput{
+			AuthenticationType: aws.String(authenticationType),
+			UserName:           aws.String(userName),
+		})
+
+		if err != nil {
+			return diag.Errorf("error disabling AppStream User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, err := tfappstream.UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := finder.UserByNameAndAuthType(ctx, conn, userName, authenticationType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppStream User (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", user.Arn)
+	d.Set("authentication_type", user.AuthenticationType)
+	d.Set("created_time", aws.TimeValue(user.CreatedTime).Format(time.RFC3339))
+	d.Set("enabled", user.Enabled)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("user_name", user.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, err := tfappstream.UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableUserWithContext(ctx, &appstream.EnableUserInput{
+				AuthenticationType: aws.String(authenticationType),
+				UserName:           aws.String(userName),
+			})
+
+			if err != nil {
+				return diag.Errorf("error enabling AppStream User (%s): %s", d.Id(), err)
+			}
+		} else {
+			_, err = conn.DisableUserWithContext(ctx, &appstream.DisableUserInput{
+				AuthenticationType: aws.String(authenticationType),
+				UserName:           aws.String(userName),
+			})
+
+			if err != nil {
+				return diag.Errorf("error disabling AppStream User (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsAppStreamUserRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, err := tfappstream.UserParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User: (%s)", d.Id())
+	_, err = conn.DeleteUserWithContext(ctx, &appstream.DeleteUserInput{
+		AuthenticationType: aws.String(authenticationType),
+		UserName:           aws.String(userName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppStream User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsAppStreamUserStackAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAppStreamUserStackAssociationCreate,
+		ReadContext:   resourceAwsAppStreamUserStackAssociationRead,
+		DeleteContext: resourceAwsAppStreamUserStackAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appstream.AuthenticationType_Values(), false),
+			},
+			"send_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsAppStreamUserStackAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName := d.Get("user_name").(string)
+	authenticationType := d.Get("authentication_type").(string)
+	stackName := d.Get("stack_name").(string)
+	id := tfappstream.UserStackAssociationCreateResourceID(userName, authenticationType, stackName)
+	input := &appstream.BatchAssociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType:    aws.String(authenticationType),
+				SendEmailNotification: aws.Bool(d.Get("send_email_notification").(bool)),
+				StackName:             aws.String(stackName),
+				UserName:              aws.String(userName),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating AppStream User Stack Association: %s", input)
+	output, err := conn.BatchAssociateUserStackWithContext(ctx, input)
+
+	if err == nil {
+		err = appStreamUserStackAssociationErrors(output.Errors)
+	}
+
+	if err != nil {
+		return diag.Errorf("error creating AppStream User Stack Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAppStreamUserStackAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsAppStreamUserStackAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := finder.UserStackAssociation(ctx, conn, userName, authenticationType, stackName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream User Stack Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppStream User Stack Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("authentication_type", association.AuthenticationType)
+	d.Set("stack_name", association.StackName)
+	d.Set("user_name", association.UserName)
+
+	return nil
+}
+
+func resourceAwsAppStreamUserStackAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting AppStream User Stack Association: (%s)", d.Id())
+	output, err := conn.BatchDisassociateUserStackWithContext(ctx, &appstream.BatchDisassociateUserStackInput{
+		UserStackAssociations: []*appstream.UserStackAssociation{
+			{
+				AuthenticationType: aws.String(authenticationType),
+				StackName:          aws.String(stackName),
+				UserName:           aws.String(userName),
+			},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil {
+		err = appStreamUserStackAssociationErrors(output.Errors)
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppStream User Stack Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// appStreamUserStackAssociationErrors returns the per-association errors from a batch operation as a single error.
+func appStreamUserStackAssociationErrors(apiObjects []*appstream.UserStackAssociationError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errors = multierror.Append(errors, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	return errors.ErrorOrNil()
+}
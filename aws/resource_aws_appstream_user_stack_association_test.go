@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamUserStackAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_user_stack_association.test"
+	domain := testAccRandomDomainName()
+	userName := fmt.Sprintf("%s@%s", acctest.RandString(8), domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserStackAssociationConfig(rName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserStackAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", appstream.AuthenticationTypeUserpool),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_name", "aws_appstream_stack.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_appstream_user.test", "user_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUserStackAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_appstream_user_stack_association.test"
+	domain := testAccRandomDomainName()
+	userName := fmt.Sprintf("%s@%s", acctest.RandString(8), domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserStackAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserStackAssociationConfig(rName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserStackAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamUserStackAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user_stack_association" {
+			continue
+		}
+
+		userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserStackAssociation(context.Background(), conn, userName, authenticationType, stackName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream User Stack Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamUserStackAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User Stack Association ID is set")
+		}
+
+		userName, authenticationType, stackName, err := tfappstream.UserStackAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err = finder.UserStackAssociation(context.Background(), conn, userName, authenticationType, stackName)
+
+		return err
+	}
+}
+
+func testAccAWSAppStreamUserStackAssociationConfig(rName, userName string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_user" "test" {
+  authentication_type = "USERPOOL"
+  user_name           = %[2]q
+}
+
+resource "aws_appstream_user_stack_association" "test" {
+  authentication_type = aws_appstream_user.test.authentication_type
+  stack_name          = aws_appstream_stack.test.name
+  user_name           = aws_appstream_user.test.user_name
+}
+`, rName, userName)
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSAppStreamUser_basic(t *testing.T) {
+	resourceName := "aws_appstream_user.test"
+	domain := testAccRandomDomainName()
+	userName := fmt.Sprintf("%s@%s", acctest.RandString(8), domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", appstream.AuthenticationTypeUserpool),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email_notification"},
+			},
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAppStreamUser_disappears(t *testing.T) {
+	resourceName := "aws_appstream_user.test"
+	domain := testAccRandomDomainName()
+	userName := fmt.Sprintf("%s@%s", acctest.RandString(8), domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appstream.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAppStreamUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAppStreamUserConfig(userName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAppStreamUserExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAppStreamUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAppStreamUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_user" {
+			continue
+		}
+
+		userName, authenticationType, err := tfappstream.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserByNameAndAuthType(context.Background(), conn, userName, authenticationType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAppStreamUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream User ID is set")
+		}
+
+		userName, authenticationType, err := tfappstream.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+
+		_, err = finder.UserByNameAndAuthType(context.Background(), conn, userName, authenticationType)
+
+		return err
+	}
+}
+
+func testAccAWSAppStreamUserConfig(userName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_user" "test" {
+  authentication_type = "USERPOOL"
+  user_name           = %[1]q
+  enabled             = %[2]t
+}
+`, userName, enabled)
+}
@@ -508,6 +508,8 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				},
 			},
 
+			"tags_all": tagsSchemaComputed(),
+
 			"service_linked_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -592,6 +594,14 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+				ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+				resourceTags := autoscalingGroupConfiguredTags(diff.Get("tag"), diff.Get("tags"), diff.Id())
+
+				return setTagsAllNewDiff(diff, defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig), "tags_all")
+			},
 			customdiff.ComputedIf("launch_template.0.id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.name")
 			}),
@@ -695,12 +705,10 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 		createOpts.AvailabilityZones = expandStringSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("tag"); ok {
-		createOpts.Tags = keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().AutoscalingTags()
-	}
+	configuredTags := autoscalingGroupConfiguredTags(d.Get("tag"), d.Get("tags"), asgName)
 
-	if v, ok := d.GetOk("tags"); ok {
-		createOpts.Tags = keyvaluetags.AutoscalingKeyValueTags(v, asgName, autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().AutoscalingTags()
+	if tags := autoscalingGroupAllTags(configuredTags, d.Get("tags_all"), asgName).IgnoreAws(); len(tags) > 0 {
+		createOpts.Tags = tags.AutoscalingTags()
 	}
 
 	if v, ok := d.GetOk("capacity_rebalance"); ok {
@@ -822,6 +830,7 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 // TODO: wrap all top-level error returns
 func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	g, err := getAwsAutoscalingGroup(d.Id(), conn)
@@ -904,12 +913,27 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	allTags := keyvaluetags.AutoscalingKeyValueTags(g.Tags, d.Id(), autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
 	if !tagOk && !tagsOk {
-		if err := d.Set("tag", keyvaluetags.AutoscalingKeyValueTags(g.Tags, d.Id(), autoscalingTagResourceTypeAutoScalingGroup).IgnoreAws().IgnoreConfig(ignoreTagsConfig).AutoscalingListOfMap()); err != nil {
+		// Provider default tags are reported in "tags_all" only.
+		resourceTags := keyvaluetags.New(allTags.Map()).RemoveDefaultConfig(defaultTagsConfig)
+
+		if err := d.Set("tag", allTags.Only(resourceTags).AutoscalingListOfMap()); err != nil {
 			return fmt.Errorf("error setting tag: %w", err)
 		}
 	}
 
+	// Tags added outside of Terraform, e.g. by the Kubernetes Cluster Autoscaler,
+	// are left unmanaged as for the "tag" and "tags" arguments.
+	managedTags := autoscalingGroupConfiguredTags(d.Get("tag"), d.Get("tags"), d.Id())
+	managedTags = managedTags.Merge(defaultTagsConfig.GetTags())
+	managedTags = managedTags.Merge(keyvaluetags.New(d.Get("tags_all").(map[string]interface{})))
+
+	if err := d.Set("tags_all", allTags.Only(managedTags).Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	if err := d.Set("target_group_arns", flattenStringList(g.TargetGroupARNs)); err != nil {
 		return fmt.Errorf("error setting target_group_arns: %s", err)
 	}
@@ -1112,17 +1136,16 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		opts.ServiceLinkedRoleARN = aws.String(d.Get("service_linked_role_arn").(string))
 	}
 
-	if d.HasChanges("tag", "tags") {
+	if d.HasChanges("tag", "tags", "tags_all") {
 		oTagRaw, nTagRaw := d.GetChange("tag")
 		oTagsRaw, nTagsRaw := d.GetChange("tags")
+		oTagsAllRaw, nTagsAllRaw := d.GetChange("tags_all")
 
-		oTag := keyvaluetags.AutoscalingKeyValueTags(oTagRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		oTags := keyvaluetags.AutoscalingKeyValueTags(oTagsRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		oldTags := oTag.Merge(oTags).AutoscalingTags()
+		oldConfiguredTags := autoscalingGroupConfiguredTags(oTagRaw, oTagsRaw, d.Id())
+		oldTags := autoscalingGroupAllTags(oldConfiguredTags, oTagsAllRaw, d.Id()).AutoscalingTags()
 
-		nTag := keyvaluetags.AutoscalingKeyValueTags(nTagRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		nTags := keyvaluetags.AutoscalingKeyValueTags(nTagsRaw, d.Id(), autoscalingTagResourceTypeAutoScalingGroup)
-		newTags := nTag.Merge(nTags).AutoscalingTags()
+		newConfiguredTags := autoscalingGroupConfiguredTags(nTagRaw, nTagsRaw, d.Id())
+		newTags := autoscalingGroupAllTags(newConfiguredTags, nTagsAllRaw, d.Id()).AutoscalingTags()
 
 		if err := keyvaluetags.AutoscalingUpdateTags(conn, d.Id(), autoscalingTagResourceTypeAutoScalingGroup, oldTags, newTags); err != nil {
 			return fmt.Errorf("error updating tags for Auto Scaling Group (%s): %w", d.Id(), err)
@@ -2342,3 +2365,27 @@ func flattenLaunchTemplateSpecification(lt *autoscaling.LaunchTemplateSpecificat
 
 	return result
 }
+
+// autoscalingGroupConfiguredTags returns the tags configured in the "tag" and "tags" arguments.
+func autoscalingGroupConfiguredTags(tag, tags interface{}, identifier string) keyvaluetags.KeyValueTags {
+	configuredTag := keyvaluetags.AutoscalingKeyValueTags(tag, identifier, autoscalingTagResourceTypeAutoScalingGroup)
+	configuredTags := keyvaluetags.AutoscalingKeyValueTags(tags, identifier, autoscalingTagResourceTypeAutoScalingGroup)
+
+	return configuredTag.Merge(configuredTags)
+}
+
+// autoscalingGroupAllTags returns the configured tags along with the other tags in "tags_all",
+// such as provider default tags, which are propagated to launched instances.
+func autoscalingGroupAllTags(configuredTags keyvaluetags.KeyValueTags, tagsAll interface{}, identifier string) keyvaluetags.KeyValueTags {
+	var otherTags []*autoscaling.Tag
+
+	for k, v := range keyvaluetags.New(tagsAll).Ignore(configuredTags).Map() {
+		otherTags = append(otherTags, &autoscaling.Tag{
+			Key:               aws.String(k),
+			PropagateAtLaunch: aws.Bool(true),
+			Value:             aws.String(v),
+		})
+	}
+
+	return configuredTags.Merge(keyvaluetags.AutoscalingKeyValueTags(otherTags, identifier, autoscalingTagResourceTypeAutoScalingGroup))
+}
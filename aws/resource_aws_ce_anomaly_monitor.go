@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCeAnomalyMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsCeAnomalyMonitorCreate,
+		ReadContext:   resourceAwsCeAnomalyMonitorRead,
+		UpdateContext: resourceAwsCeAnomalyMonitorUpdate,
+		DeleteContext: resourceAwsCeAnomalyMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_dimension": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"monitor_specification"},
+				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
+			},
+			"monitor_specification": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"monitor_dimension"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.MonitorType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsCeAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	anomalyMonitor := &costexplorer.AnomalyMonitor{
+		MonitorName: aws.String(d.Get("name").(string)),
+		MonitorType: aws.String(d.Get("monitor_type").(string)),
+	}
+
+	if v, ok := d.GetOk("monitor_dimension"); ok {
+		anomalyMonitor.MonitorDimension = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("monitor_specification"); ok {
+		expression := &costexplorer.Expression{}
+
+		if err := json.Unmarshal([]byte(v.(string)), expression); err != nil {
+			return diag.Errorf("error parsing monitor_specification: %s", err)
+		}
+
+		anomalyMonitor.MonitorSpecification = expression
+	}
+
+	input := &costexplorer.CreateAnomalyMonitorInput{
+		AnomalyMonitor: anomalyMonitor,
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Monitor: %s", input)
+	output, err := conn.CreateAnomalyMonitorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Anomaly Monitor (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.MonitorArn))
+
+	return resourceAwsCeAnomalyMonitorRead(ctx, d, meta)
+}
+
+func resourceAwsCeAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	anomalyMonitor, err := finder.AnomalyMonitorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", anomalyMonitor.MonitorArn)
+	d.Set("monitor_dimension", anomalyMonitor.MonitorDimension)
+	d.Set("monitor_type", anomalyMonitor.MonitorType)
+	d.Set("name", anomalyMonitor.MonitorName)
+
+	if anomalyMonitor.MonitorSpecification != nil {
+		b, err := jsonutil.BuildJSON(anomalyMonitor.MonitorSpecification)
+
+		if err != nil {
+			return diag.Errorf("error converting Cost Explorer Anomaly Monitor (%s) specification to JSON: %s", d.Id(), err)
+		}
+
+		d.Set("monitor_specification", string(b))
+	} else {
+		d.Set("monitor_specification", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsCeAnomalyMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	if d.HasChange("name") {
+		input := &costexplorer.UpdateAnomalyMonitorInput{
+			MonitorArn:  aws.String(d.Id()),
+			MonitorName: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Anomaly Monitor: %s", input)
+		_, err := conn.UpdateAnomalyMonitorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCeAnomalyMonitorRead(ctx, d, meta)
+}
+
+func resourceAwsCeAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Monitor: %s", d.Id())
+	_, err := conn.DeleteAnomalyMonitorWithContext(ctx, &costexplorer.DeleteAnomalyMonitorInput{
+		MonitorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
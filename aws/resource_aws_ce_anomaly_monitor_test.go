@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCeAnomalyMonitor_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalyMonitorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalyMonitorExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalymonitor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_specification"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", costexplorer.MonitorTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeAnomalyMonitor_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalyMonitorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalyMonitorExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCeAnomalyMonitor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeAnomalyMonitor_Name(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalyMonitorConfig(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCeAnomalyMonitorConfig(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCeAnomalyMonitorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_monitor" {
+			continue
+		}
+
+		_, err := finder.AnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Monitor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCeAnomalyMonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Monitor ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+		_, err := finder.AnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCeAnomalyMonitorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Tags = {
+      Key          = "CostCenter"
+      MatchOptions = ["EQUALS"]
+      Values       = ["10000"]
+    }
+  })
+}
+`, rName)
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCeAnomalySubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsCeAnomalySubscriptionCreate,
+		ReadContext:   resourceAwsCeAnomalySubscriptionRead,
+		UpdateContext: resourceAwsCeAnomalySubscriptionUpdate,
+		DeleteContext: resourceAwsCeAnomalySubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.AnomalySubscriptionFrequency_Values(), false),
+			},
+			"monitor_arn_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(6, 302),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.SubscriberType_Values(), false),
+						},
+					},
+				},
+			},
+			"threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0.0),
+			},
+		},
+	}
+}
+
+func resourceAwsCeAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	anomalySubscription := &costexplorer.AnomalySubscription{
+		Frequency:        aws.String(d.Get("frequency").(string)),
+		MonitorArnList:   expandStringList(d.Get("monitor_arn_list").([]interface{})),
+		Subscribers:      expandCeAnomalySubscriptionSubscribers(d.Get("subscriber").(*schema.Set).List()),
+		SubscriptionName: aws.String(d.Get("name").(string)),
+		Threshold:        aws.Float64(d.Get("threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("account_id"); ok {
+		anomalySubscription.AccountId = aws.String(v.(string))
+	}
+
+	input := &costexplorer.CreateAnomalySubscriptionInput{
+		AnomalySubscription: anomalySubscription,
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Subscription: %s", input)
+	output, err := conn.CreateAnomalySubscriptionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Anomaly Subscription (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.SubscriptionArn))
+
+	return resourceAwsCeAnomalySubscriptionRead(ctx, d, meta)
+}
+
+func resourceAwsCeAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	anomalySubscription, err := finder.AnomalySubscriptionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", anomalySubscription.AccountId)
+	d.Set("arn", anomalySubscription.SubscriptionArn)
+	d.Set("frequency", anomalySubscription.Frequency)
+	d.Set("monitor_arn_list", aws.StringValueSlice(anomalySubscription.MonitorArnList))
+	d.Set("name", anomalySubscription.SubscriptionName)
+	d.Set("threshold", anomalySubscription.Threshold)
+
+	if err := d.Set("subscriber", flattenCeAnomalySubscriptionSubscribers(anomalySubscription.Subscribers)); err != nil {
+		return diag.Errorf("error setting subscriber: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCeAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	input := &costexplorer.UpdateAnomalySubscriptionInput{
+		SubscriptionArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("frequency") {
+		input.Frequency = aws.String(d.Get("frequency").(string))
+	}
+
+	if d.HasChange("monitor_arn_list") {
+		input.MonitorArnList = expandStringList(d.Get("monitor_arn_list").([]interface{}))
+	}
+
+	if d.HasChange("name") {
+		input.SubscriptionName = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("subscriber") {
+		input.Subscribers = expandCeAnomalySubscriptionSubscribers(d.Get("subscriber").(*schema.Set).List())
+	}
+
+	if d.HasChange("threshold") {
+		input.Threshold = aws.Float64(d.Get("threshold").(float64))
+	}
+
+	log.Printf("[DEBUG] Updating Cost Explorer Anomaly Subscription: %s", input)
+	_, err := conn.UpdateAnomalySubscriptionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCeAnomalySubscriptionRead(ctx, d, meta)
+}
+
+func resourceAwsCeAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Subscription: %s", d.Id())
+	_, err := conn.DeleteAnomalySubscriptionWithContext(ctx, &costexplorer.DeleteAnomalySubscriptionInput{
+		SubscriptionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownSubscriptionException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCeAnomalySubscriptionSubscriber(tfMap map[string]interface{}) *costexplorer.Subscriber {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Subscriber{}
+
+	if v, ok := tfMap["address"].(string); ok && v != "" {
+		apiObject.Address = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCeAnomalySubscriptionSubscribers(tfList []interface{}) []*costexplorer.Subscriber {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.Subscriber
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCeAnomalySubscriptionSubscriber(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCeAnomalySubscriptionSubscriber(apiObject *costexplorer.Subscriber) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Address; v != nil {
+		tfMap["address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeAnomalySubscriptionSubscribers(apiObjects []*costexplorer.Subscriber) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCeAnomalySubscriptionSubscriber(apiObject))
+	}
+
+	return tfList
+}
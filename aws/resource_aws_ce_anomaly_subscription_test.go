@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCeAnomalySubscription_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_subscription.test"
+	monitorResourceName := "aws_ce_anomaly_monitor.test"
+	address := testAccDefaultEmailAddress
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalySubscriptionConfig(rName, address, costexplorer.AnomalySubscriptionFrequencyDaily, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalySubscriptionExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalysubscription/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "frequency", costexplorer.AnomalySubscriptionFrequencyDaily),
+					resource.TestCheckResourceAttr(resourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_arn_list.0", monitorResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address,
+						"type":    costexplorer.SubscriberTypeEmail,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeAnomalySubscription_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_subscription.test"
+	address := testAccDefaultEmailAddress
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalySubscriptionConfig(rName, address, costexplorer.AnomalySubscriptionFrequencyDaily, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalySubscriptionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCeAnomalySubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeAnomalySubscription_FrequencyAndThreshold(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_anomaly_subscription.test"
+	address := testAccDefaultEmailAddress
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeAnomalySubscriptionConfig(rName, address, costexplorer.AnomalySubscriptionFrequencyDaily, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", costexplorer.AnomalySubscriptionFrequencyDaily),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCeAnomalySubscriptionConfig(rName, address, costexplorer.AnomalySubscriptionFrequencyWeekly, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", costexplorer.AnomalySubscriptionFrequencyWeekly),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCeAnomalySubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_subscription" {
+			continue
+		}
+
+		_, err := finder.AnomalySubscriptionByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Subscription %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCeAnomalySubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Subscription ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+		_, err := finder.AnomalySubscriptionByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCeAnomalySubscriptionConfig(rName, address, frequency string, threshold int) string {
+	return composeConfig(testAccAWSCeAnomalyMonitorConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = %[3]q
+  threshold = %[4]d
+
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+}
+`, rName, address, frequency, threshold))
+}
@@ -0,0 +1,569 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCeCostCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsCeCostCategoryCreate,
+		ReadContext:   resourceAwsCeCostCategoryRead,
+		UpdateContext: resourceAwsCeCostCategoryUpdate,
+		DeleteContext: resourceAwsCeCostCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"dimension_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryInheritedValueDimensionName_Values(), false),
+									},
+								},
+							},
+						},
+						"rule": ceExpressionSchema(3),
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      costexplorer.CostCategoryRuleTypeRegular,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      costexplorer.CostCategoryRuleVersionCostCategoryExpressionV1,
+				ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleVersion_Values(), false),
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeMethod_Values(), false),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeRuleParameterType_Values(), false),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 500,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 1024),
+										},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 500,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCeCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	input := &costexplorer.CreateCostCategoryDefinitionInput{
+		Name:        aws.String(d.Get("name").(string)),
+		Rules:       expandCeCostCategoryRules(d.Get("rule").([]interface{})),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCeCostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Cost Category: %s", input)
+	output, err := conn.CreateCostCategoryDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Cost Category (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.CostCategoryArn))
+
+	return resourceAwsCeCostCategoryRead(ctx, d, meta)
+}
+
+func resourceAwsCeCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	costCategory, err := finder.CostCategoryByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Cost Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", costCategory.CostCategoryArn)
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+	d.Set("rule_version", costCategory.RuleVersion)
+
+	if err := d.Set("rule", flattenCeCostCategoryRules(costCategory.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	if err := d.Set("split_charge_rule", flattenCeCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return diag.Errorf("error setting split_charge_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCeCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	input := &costexplorer.UpdateCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+		Rules:           expandCeCostCategoryRules(d.Get("rule").([]interface{})),
+		RuleVersion:     aws.String(d.Get("rule_version").(string)),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCeCostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Updating Cost Explorer Cost Category: %s", input)
+	_, err := conn.UpdateCostCategoryDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCeCostCategoryRead(ctx, d, meta)
+}
+
+func resourceAwsCeCostCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ceconn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Cost Category: %s", d.Id())
+	_, err := conn.DeleteCostCategoryDefinitionWithContext(ctx, &costexplorer.DeleteCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCeCostCategoryRule(tfMap map[string]interface{}) *costexplorer.CostCategoryRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryRule{}
+
+	if v, ok := tfMap["inherited_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InheritedValue = expandCeCostCategoryInheritedValue(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Rule = expandCeExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCeCostCategoryRules(tfList []interface{}) []*costexplorer.CostCategoryRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategoryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCeCostCategoryRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCeCostCategoryInheritedValue(tfMap map[string]interface{}) *costexplorer.CostCategoryInheritedValueDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryInheritedValueDimension{}
+
+	if v, ok := tfMap["dimension_key"].(string); ok && v != "" {
+		apiObject.DimensionKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+		apiObject.DimensionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCeCostCategorySplitChargeRule(tfMap map[string]interface{}) *costexplorer.CostCategorySplitChargeRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategorySplitChargeRule{}
+
+	if v, ok := tfMap["method"].(string); ok && v != "" {
+		apiObject.Method = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Parameters = expandCeCostCategorySplitChargeRuleParameters(v.List())
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["targets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Targets = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCeCostCategorySplitChargeRules(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCeCostCategorySplitChargeRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCeCostCategorySplitChargeRuleParameter(tfMap map[string]interface{}) *costexplorer.CostCategorySplitChargeRuleParameter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategorySplitChargeRuleParameter{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Values = expandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandCeCostCategorySplitChargeRuleParameters(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRuleParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCeCostCategorySplitChargeRuleParameter(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCeCostCategoryRule(apiObject *costexplorer.CostCategoryRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InheritedValue; v != nil {
+		tfMap["inherited_value"] = []interface{}{flattenCeCostCategoryInheritedValue(v)}
+	}
+
+	if v := apiObject.Rule; v != nil {
+		tfMap["rule"] = []interface{}{flattenCeExpression(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeCostCategoryRules(apiObjects []*costexplorer.CostCategoryRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCeCostCategoryRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCeCostCategoryInheritedValue(apiObject *costexplorer.CostCategoryInheritedValueDimension) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DimensionKey; v != nil {
+		tfMap["dimension_key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DimensionName; v != nil {
+		tfMap["dimension_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeCostCategorySplitChargeRule(apiObject *costexplorer.CostCategorySplitChargeRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Method; v != nil {
+		tfMap["method"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		tfMap["parameter"] = flattenCeCostCategorySplitChargeRuleParameters(v)
+	}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Targets; v != nil {
+		tfMap["targets"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeCostCategorySplitChargeRules(apiObjects []*costexplorer.CostCategorySplitChargeRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCeCostCategorySplitChargeRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCeCostCategorySplitChargeRuleParameter(apiObject *costexplorer.CostCategorySplitChargeRuleParameter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCeCostCategorySplitChargeRuleParameters(apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCeCostCategorySplitChargeRuleParameter(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/costexplorer/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCeCostCategory_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`costcategory/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_value", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "effective_start"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.value", "production"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.type", costexplorer.CostCategoryRuleTypeRegular),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.key", costexplorer.DimensionLinkedAccountName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.rule.0.dimension.0.values.*", "-prod"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.value", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", costexplorer.CostCategoryRuleVersionCostCategoryExpressionV1),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeCostCategory_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCeCostCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeCostCategory_DefaultValue(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeCostCategoryConfigDefaultValue(rName, "default1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "default1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCeCostCategoryConfigDefaultValue(rName, "default2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "default2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCeCostCategory_NestedExpression(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeCostCategoryConfigNestedExpression(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.0.tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.0.tags.0.key", "team"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.1.not.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.and.1.not.0.dimension.0.key", costexplorer.DimensionRegion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCeCostCategory_SplitChargeRule(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCeCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCeCostCategoryConfigSplitChargeRule(rName, costexplorer.CostCategorySplitChargeMethodProportional),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method":    costexplorer.CostCategorySplitChargeMethodProportional,
+						"source":    "production",
+						"targets.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCeCostCategoryConfigSplitChargeRule(rName, costexplorer.CostCategorySplitChargeMethodEven),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCeCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method": costexplorer.CostCategorySplitChargeMethodEven,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCeCostCategoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_cost_category" {
+			continue
+		}
+
+		_, err := finder.CostCategoryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Cost Category %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCeCostCategoryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Cost Category ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ceconn
+
+		_, err := finder.CostCategoryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCeCostCategoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name = %[1]q
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCeCostCategoryConfigDefaultValue(rName, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  default_value = %[2]q
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+}
+`, rName, defaultValue)
+}
+
+func testAccAWSCeCostCategoryConfigNestedExpression(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name = %[1]q
+
+  rule {
+    value = "platform"
+
+    rule {
+      and {
+        tags {
+          key           = "team"
+          values        = ["platform"]
+          match_options = ["EQUALS"]
+        }
+      }
+
+      and {
+        not {
+          dimension {
+            key    = "REGION"
+            values = ["us-east-1"]
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSCeCostCategoryConfigSplitChargeRule(rName, method string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name = %[1]q
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  split_charge_rule {
+    method  = %[2]q
+    source  = "production"
+    targets = ["staging"]
+  }
+}
+`, rName, method)
+}
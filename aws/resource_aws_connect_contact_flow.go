@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectContactFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectContactFlowCreate,
+		ReadContext:   resourceAwsConnectContactFlowRead,
+		UpdateContext: resourceAwsConnectContactFlowUpdate,
+		DeleteContext: resourceAwsConnectContactFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_flow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"content", "filename"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"content_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filename": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"content", "filename"},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connect.ContactFlowTypeContactFlow,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsConnectContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	content, err := connectContactFlowContent(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &connect.CreateContactFlowInput{
+		Content:    aws.String(content),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Type:       aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Contact Flow: %s", input)
+	output, err := conn.CreateContactFlowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Contact Flow (%s): %s", name, err)
+	}
+
+	d.SetId(tfconnect.ContactFlowCreateResourceID(instanceID, aws.StringValue(output.ContactFlowId)))
+
+	return resourceAwsConnectContactFlowRead(ctx, d, meta)
+}
+
+func resourceAwsConnectContactFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contactFlow, err := finder.ContactFlowByID(ctx, conn, instanceID, contactFlowID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Contact Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Contact Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", contactFlow.Arn)
+	d.Set("contact_flow_id", contactFlow.Id)
+	d.Set("content", contactFlow.Content)
+	d.Set("description", contactFlow.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", contactFlow.Name)
+	d.Set("type", contactFlow.Type)
+
+	tags := keyvaluetags.ConnectKeyValueTags(contactFlow.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateContactFlowNameInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Description:   aws.String(d.Get("description").(string)),
+			InstanceId:    aws.String(instanceID),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow name: %s", input)
+		_, err := conn.UpdateContactFlowNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Contact Flow (%s) name: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("content", "content_hash", "filename") {
+		content, err := connectContactFlowContent(d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &connect.UpdateContactFlowContentInput{
+			ContactFlowId: aws.String(contactFlowID),
+			Content:       aws.String(content),
+			InstanceId:    aws.String(instanceID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Contact Flow content: %s", input)
+		_, err = conn.UpdateContactFlowContentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Contact Flow (%s) content: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Connect Contact Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectContactFlowRead(ctx, d, meta)
+}
+
+func resourceAwsConnectContactFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Connect API does not support deleting contact flows.
+	log.Printf("[WARN] Connect Contact Flow (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+// connectContactFlowContent returns the contact flow content, either inline or loaded from the configured file.
+func connectContactFlowContent(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("filename"); ok {
+		content, err := loadFileContent(v.(string))
+
+		if err != nil {
+			return "", fmt.Errorf("error reading Connect Contact Flow content from file (%s): %w", v.(string), err)
+		}
+
+		return string(content), nil
+	}
+
+	return d.Get("content").(string), nil
+}
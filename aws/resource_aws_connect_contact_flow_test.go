@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func TestAccAWSConnectContactFlow_basic(t *testing.T) {
+	var v connect.ContactFlow
+	resourceName := "aws_connect_contact_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfig(rName, "Original", "Thanks for calling the sample flow!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/contact-flow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Original"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", connect.ContactFlowTypeContactFlow),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfig(rName, "Updated", "Thanks for calling the updated flow!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`updated flow`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectContactFlow_filename(t *testing.T) {
+	var v connect.ContactFlow
+	resourceName := "aws_connect_contact_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigFilename(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_hash"),
+					resource.TestCheckResourceAttr(resourceName, "filename", "test-fixtures/connect_contact_flow.json"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_hash", "filename"},
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectContactFlow_tags(t *testing.T) {
+	var v connect.ContactFlow
+	resourceName := "aws_connect_contact_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_hash", "filename"},
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSConnectContactFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectContactFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectContactFlowExists(n string, v *connect.ContactFlow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Contact Flow ID is set")
+		}
+
+		instanceID, contactFlowID, err := tfconnect.ContactFlowParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.ContactFlowByID(context.Background(), conn, instanceID, contactFlowID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSConnectContactFlowConfig(rName, description, text string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Transitions = {
+          NextAction = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+          Errors     = []
+          Conditions = []
+        }
+        Parameters = {
+          Text = %[3]q
+        }
+      },
+      {
+        Identifier  = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+        Type        = "DisconnectParticipant"
+        Transitions = {}
+        Parameters  = {}
+      }
+    ]
+  })
+}
+`, rName, description, text))
+}
+
+func testAccAWSConnectContactFlowConfigFilename(rName string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id  = aws_connect_instance.test.id
+  name         = %[1]q
+  filename     = "test-fixtures/connect_contact_flow.json"
+  content_hash = filebase64sha256("test-fixtures/connect_contact_flow.json")
+}
+`, rName))
+}
+
+func testAccAWSConnectContactFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id  = aws_connect_instance.test.id
+  name         = %[1]q
+  filename     = "test-fixtures/connect_contact_flow.json"
+  content_hash = filebase64sha256("test-fixtures/connect_contact_flow.json")
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSConnectContactFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id  = aws_connect_instance.test.id
+  name         = %[1]q
+  filename     = "test-fixtures/connect_contact_flow.json"
+  content_hash = filebase64sha256("test-fixtures/connect_contact_flow.json")
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
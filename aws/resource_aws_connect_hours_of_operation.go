@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectHoursOfOperation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectHoursOfOperationCreate,
+		ReadContext:   resourceAwsConnectHoursOfOperationRead,
+		UpdateContext: resourceAwsConnectHoursOfOperationUpdate,
+		DeleteContext: resourceAwsConnectHoursOfOperationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.HoursOfOperationDays_Values(), false),
+						},
+						"end_time":   connectHoursOfOperationTimeSliceSchema(),
+						"start_time": connectHoursOfOperationTimeSliceSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"hours_of_operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"time_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func connectHoursOfOperationTimeSliceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hours": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 23),
+				},
+				"minutes": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 59),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsConnectHoursOfOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateHoursOfOperationInput{
+		Config:     expandConnectHoursOfOperationConfigs(d.Get("config").(*schema.Set).List()),
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		TimeZone:   aws.String(d.Get("time_zone").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Hours of Operation: %s", input)
+	output, err := conn.CreateHoursOfOperationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Hours of Operation (%s): %s", name, err)
+	}
+
+	d.SetId(tfconnect.HoursOfOperationCreateResourceID(instanceID, aws.StringValue(output.HoursOfOperationId)))
+
+	return resourceAwsConnectHoursOfOperationRead(ctx, d, meta)
+}
+
+func resourceAwsConnectHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, hoursOfOperationID, err := tfconnect.HoursOfOperationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hoursOfOperation, err := finder.HoursOfOperationByID(ctx, conn, instanceID, hoursOfOperationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Hours of Operation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Hours of Operation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", hoursOfOperation.HoursOfOperationArn)
+	if err := d.Set("config", flattenConnectHoursOfOperationConfigs(hoursOfOperation.Config)); err != nil {
+		return diag.Errorf("error setting config: %s", err)
+	}
+	d.Set("description", hoursOfOperation.Description)
+	d.Set("hours_of_operation_id", hoursOfOperation.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("name", hoursOfOperation.Name)
+	d.Set("time_zone", hoursOfOperation.TimeZone)
+
+	tags := keyvaluetags.ConnectKeyValueTags(hoursOfOperation.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectHoursOfOperationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, hoursOfOperationID, err := tfconnect.HoursOfOperationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &connect.UpdateHoursOfOperationInput{
+			Config:             expandConnectHoursOfOperationConfigs(d.Get("config").(*schema.Set).List()),
+			HoursOfOperationId: aws.String(hoursOfOperationID),
+			InstanceId:         aws.String(instanceID),
+			Name:               aws.String(d.Get("name").(string)),
+			TimeZone:           aws.String(d.Get("time_zone").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Connect Hours of Operation: %s", input)
+		_, err := conn.UpdateHoursOfOperationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Hours of Operation (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Connect Hours of Operation (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectHoursOfOperationRead(ctx, d, meta)
+}
+
+func resourceAwsConnectHoursOfOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, hoursOfOperationID, err := tfconnect.HoursOfOperationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Hours of Operation: %s", d.Id())
+	_, err = conn.DeleteHoursOfOperationWithContext(ctx, &connect.DeleteHoursOfOperationInput{
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+		InstanceId:         aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Connect Hours of Operation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConnectHoursOfOperationConfigs(tfList []interface{}) []*connect.HoursOfOperationConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*connect.HoursOfOperationConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.HoursOfOperationConfig{}
+
+		if v, ok := tfMap["day"].(string); ok && v != "" {
+			apiObject.Day = aws.String(v)
+		}
+
+		if v, ok := tfMap["end_time"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EndTime = expandConnectHoursOfOperationTimeSlice(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["start_time"].([]interface{}); ok && len(v) > 0 {
+			apiObject.StartTime = expandConnectHoursOfOperationTimeSlice(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConnectHoursOfOperationTimeSlice(tfMap map[string]interface{}) *connect.HoursOfOperationTimeSlice {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &connect.HoursOfOperationTimeSlice{}
+
+	if v, ok := tfMap["hours"].(int); ok {
+		apiObject.Hours = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minutes"].(int); ok {
+		apiObject.Minutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenConnectHoursOfOperationConfigs(apiObjects []*connect.HoursOfOperationConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"day": aws.StringValue(apiObject.Day),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = []interface{}{flattenConnectHoursOfOperationTimeSlice(v)}
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap["start_time"] = []interface{}{flattenConnectHoursOfOperationTimeSlice(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenConnectHoursOfOperationTimeSlice(apiObject *connect.HoursOfOperationTimeSlice) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"hours":   aws.Int64Value(apiObject.Hours),
+		"minutes": aws.Int64Value(apiObject.Minutes),
+	}
+}
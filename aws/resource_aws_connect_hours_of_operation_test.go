@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSConnectHoursOfOperation_basic(t *testing.T) {
+	var v connect.HoursOfOperation
+	resourceName := "aws_connect_hours_of_operation.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectHoursOfOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectHoursOfOperationConfig(rName, "Original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/operating-hours/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "config.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "config.*", map[string]string{
+						"day":                  connect.HoursOfOperationDaysMonday,
+						"end_time.#":           "1",
+						"end_time.0.hours":     "23",
+						"end_time.0.minutes":   "8",
+						"start_time.#":         "1",
+						"start_time.0.hours":   "8",
+						"start_time.0.minutes": "0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "Original"),
+					resource.TestCheckResourceAttrSet(resourceName, "hours_of_operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "EST"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectHoursOfOperationConfig(rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectHoursOfOperation_disappears(t *testing.T) {
+	var v connect.HoursOfOperation
+	resourceName := "aws_connect_hours_of_operation.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectHoursOfOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectHoursOfOperationConfig(rName, "Original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectHoursOfOperation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectHoursOfOperation_tags(t *testing.T) {
+	var v connect.HoursOfOperation
+	resourceName := "aws_connect_hours_of_operation.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectHoursOfOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectHoursOfOperationConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectHoursOfOperationConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSConnectHoursOfOperationConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectHoursOfOperationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectHoursOfOperationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_hours_of_operation" {
+			continue
+		}
+
+		instanceID, hoursOfOperationID, err := tfconnect.HoursOfOperationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.HoursOfOperationByID(context.Background(), conn, instanceID, hoursOfOperationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Hours of Operation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSConnectHoursOfOperationExists(n string, v *connect.HoursOfOperation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Hours of Operation ID is set")
+		}
+
+		instanceID, hoursOfOperationID, err := tfconnect.HoursOfOperationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.HoursOfOperationByID(context.Background(), conn, instanceID, hoursOfOperationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSConnectHoursOfOperationConfig(rName, description string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_hours_of_operation" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+  time_zone   = "EST"
+
+  config {
+    day = "MONDAY"
+
+    end_time {
+      hours   = 23
+      minutes = 8
+    }
+
+    start_time {
+      hours   = 8
+      minutes = 0
+    }
+  }
+}
+`, rName, description))
+}
+
+func testAccAWSConnectHoursOfOperationConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_hours_of_operation" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  time_zone   = "EST"
+
+  config {
+    day = "MONDAY"
+
+    end_time {
+      hours   = 23
+      minutes = 8
+    }
+
+    start_time {
+      hours   = 8
+      minutes = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSConnectHoursOfOperationConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSConnectInstanceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_hours_of_operation" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  time_zone   = "EST"
+
+  config {
+    day = "MONDAY"
+
+    end_time {
+      hours   = 23
+      minutes = 8
+    }
+
+    start_time {
+      hours   = 8
+      minutes = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// connectInstanceAttributeMap maps Terraform argument names to Connect instance attribute types.
+// Inbound and outbound calls are handled separately as they are also set on instance creation.
+var connectInstanceAttributeMap = map[string]string{
+	"auto_resolve_best_voices_enabled": connect.InstanceAttributeTypeAutoResolveBestVoices,
+	"contact_flow_logs_enabled":        connect.InstanceAttributeTypeContactflowLogs,
+	"contact_lens_enabled":             connect.InstanceAttributeTypeContactLens,
+	"early_media_enabled":              connect.InstanceAttributeTypeEarlyMedia,
+	"use_custom_tts_voices_enabled":    connect.InstanceAttributeTypeUseCustomTtsVoices,
+}
+
+func resourceAwsConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectInstanceCreate,
+		ReadContext:   resourceAwsConnectInstanceRead,
+		UpdateContext: resourceAwsConnectInstanceUpdate,
+		DeleteContext: resourceAwsConnectInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.InstanceCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.InstanceDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_resolve_best_voices_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"contact_flow_logs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"contact_lens_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(12, 12),
+			},
+			"early_media_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"identity_management_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.DirectoryType_Values(), false),
+			},
+			"inbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"instance_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 62),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`), "must contain only alphanumeric characters and hyphens, and must not begin or end with a hyphen"),
+				),
+			},
+			"outbound_calls_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_custom_tts_voices_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsConnectInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	input := &connect.CreateInstanceInput{
+		ClientToken:            aws.String(resource.UniqueId()),
+		IdentityManagementType: aws.String(d.Get("identity_management_type").(string)),
+		InboundCallsEnabled:    aws.Bool(d.Get("inbound_calls_enabled").(bool)),
+		OutboundCallsEnabled:   aws.Bool(d.Get("outbound_calls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_alias"); ok {
+		input.InstanceAlias = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Connect Instance: %s", input)
+	output, err := conn.CreateInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waiter.InstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Connect Instance (%s) create: %s", d.Id(), err)
+	}
+
+	for key, attributeType := range connectInstanceAttributeMap {
+		if err := resourceAwsConnectInstanceUpdateAttribute(ctx, conn, d.Id(), attributeType, d.Get(key).(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(ctx, d, meta)
+}
+
+func resourceAwsConnectInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instance, err := finder.InstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", instance.Arn)
+	if instance.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(instance.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("identity_management_type", instance.IdentityManagementType)
+	d.Set("inbound_calls_enabled", instance.InboundCallsEnabled)
+	d.Set("instance_alias", instance.InstanceAlias)
+	d.Set("outbound_calls_enabled", instance.OutboundCallsEnabled)
+	d.Set("service_role", instance.ServiceRole)
+	d.Set("status", instance.InstanceStatus)
+
+	for key, attributeType := range connectInstanceAttributeMap {
+		value, err := finder.InstanceAttribute(ctx, conn, d.Id(), attributeType)
+
+		if err != nil {
+			return diag.Errorf("error reading Connect Instance (%s) attribute (%s): %s", d.Id(), attributeType, err)
+		}
+
+		enabled, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return diag.Errorf("error parsing Connect Instance (%s) attribute (%s) value (%s): %s", d.Id(), attributeType, value, err)
+		}
+
+		d.Set(key, enabled)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	if d.HasChange("inbound_calls_enabled") {
+		if err := resourceAwsConnectInstanceUpdateAttribute(ctx, conn, d.Id(), connect.InstanceAttributeTypeInboundCalls, d.Get("inbound_calls_enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("outbound_calls_enabled") {
+		if err := resourceAwsConnectInstanceUpdateAttribute(ctx, conn, d.Id(), connect.InstanceAttributeTypeOutboundCalls, d.Get("outbound_calls_enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for key, attributeType := range connectInstanceAttributeMap {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if err := resourceAwsConnectInstanceUpdateAttribute(ctx, conn, d.Id(), attributeType, d.Get(key).(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAwsConnectInstanceRead(ctx, d, meta)
+}
+
+func resourceAwsConnectInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	log.Printf("[DEBUG] Deleting Connect Instance: %s", d.Id())
+	_, err := conn.DeleteInstanceWithContext(ctx, &connect.DeleteInstanceInput{
+		InstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Connect Instance (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.InstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Connect Instance (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectInstanceUpdateAttribute(ctx context.Context, conn *connect.Connect, instanceID, attributeType string, enabled bool) error {
+	input := &connect.UpdateInstanceAttributeInput{
+		AttributeType: aws.String(attributeType),
+		InstanceId:    aws.String(instanceID),
+		Value:         aws.String(strconv.FormatBool(enabled)),
+	}
+
+	log.Printf("[DEBUG] Updating Connect Instance attribute: %s", input)
+	_, err := conn.UpdateInstanceAttributeWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Connect Instance (%s) attribute (%s): %w", instanceID, attributeType, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_connect_instance", &resource.Sweeper{
+		Name: "aws_connect_instance",
+		F:    testSweepConnectInstances,
+	})
+}
+
+func testSweepConnectInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).connectconn
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &connect.ListInstancesInput{}
+
+	err = conn.ListInstancesPages(input, func(page *connect.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instance := range page.InstanceSummaryList {
+			id := aws.StringValue(instance.Id)
+
+			log.Printf("[INFO] Deleting Connect Instance (%s)", id)
+			r := resourceAwsConnectInstance()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Connect Instances: %w", err))
+	}
+
+	if err := testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Connect Instances for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Connect Instances sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSConnectInstance_basic(t *testing.T) {
+	var v connect.Instance
+	resourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve_best_voices_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "identity_management_type", connect.DirectoryTypeConnectManaged),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_alias", rName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_role"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.InstanceStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "use_custom_tts_voices_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectInstanceConfigAttributes(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve_best_voices_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "contact_flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "contact_lens_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_custom_tts_voices_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSConnectInstance_disappears(t *testing.T) {
+	var v connect.Instance
+	resourceName := "aws_connect_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectInstanceExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConnectInstance(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_instance" {
+			continue
+		}
+
+		_, err := finder.InstanceByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Instance %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSConnectInstanceExists(n string, v *connect.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.InstanceByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSConnectInstanceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccAWSConnectInstanceConfigAttributes(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = %[2]t
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = %[2]t
+
+  auto_resolve_best_voices_enabled = %[2]t
+  contact_flow_logs_enabled        = %[3]t
+  contact_lens_enabled             = %[2]t
+  early_media_enabled              = %[2]t
+  use_custom_tts_voices_enabled    = %[3]t
+}
+`, rName, enabled, !enabled)
+}
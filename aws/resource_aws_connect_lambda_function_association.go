@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectLambdaFunctionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectLambdaFunctionAssociationCreate,
+		ReadContext:   resourceAwsConnectLambdaFunctionAssociationRead,
+		DeleteContext: resourceAwsConnectLambdaFunctionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceAwsConnectLambdaFunctionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID := d.Get("instance_id").(string)
+	functionARN := d.Get("function_arn").(string)
+	id := tfconnect.LambdaFunctionAssociationCreateResourceID(instanceID, functionARN)
+
+	input := &connect.AssociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionARN),
+		InstanceId:  aws.String(instanceID),
+	}
+
+	log.Printf("[DEBUG] Creating Connect Lambda Function Association: %s", input)
+	_, err := conn.AssociateLambdaFunctionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Lambda Function Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsConnectLambdaFunctionAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsConnectLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, functionARN, err := tfconnect.LambdaFunctionAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = finder.LambdaFunctionAssociationByARN(ctx, conn, instanceID, functionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Lambda Function Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Lambda Function Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", functionARN)
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceAwsConnectLambdaFunctionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, functionARN, err := tfconnect.LambdaFunctionAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Lambda Function Association: %s", d.Id())
+	_, err = conn.DisassociateLambdaFunctionWithContext(ctx, &connect.DisassociateLambdaFunctionInput{
+		FunctionArn: aws.String(functionARN),
+		InstanceId:  aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Connect Lambda Function Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
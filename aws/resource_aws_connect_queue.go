@@ -0,0 +1,412 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectQueueCreate,
+		ReadContext:   resourceAwsConnectQueueRead,
+		UpdateContext: resourceAwsConnectQueueUpdate,
+		DeleteContext: resourceAwsConnectQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"hours_of_operation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"max_contacts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"outbound_caller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"outbound_caller_id_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"outbound_caller_id_number_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"outbound_flow_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"quick_connect_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(connect.QueueStatus_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsConnectQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateQueueInput{
+		HoursOfOperationId: aws.String(d.Get("hours_of_operation_id").(string)),
+		InstanceId:         aws.String(instanceID),
+		Name:               aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_contacts"); ok {
+		input.MaxContacts = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("outbound_caller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OutboundCallerConfig = expandConnectOutboundCallerConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("quick_connect_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.QuickConnectIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Queue: %s", input)
+	output, err := conn.CreateQueueWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Queue (%s): %s", name, err)
+	}
+
+	queueID := aws.StringValue(output.QueueId)
+	d.SetId(tfconnect.QueueCreateResourceID(instanceID, queueID))
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != connect.QueueStatusEnabled {
+		if err := resourceAwsConnectQueueUpdateStatus(ctx, conn, instanceID, queueID, v.(string)); err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectQueueRead(ctx, d, meta)
+}
+
+func resourceAwsConnectQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, queueID, err := tfconnect.QueueParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queue, err := finder.QueueByID(ctx, conn, instanceID, queueID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Queue (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", queue.QueueArn)
+	d.Set("description", queue.Description)
+	d.Set("hours_of_operation_id", queue.HoursOfOperationId)
+	d.Set("instance_id", instanceID)
+	d.Set("max_contacts", queue.MaxContacts)
+	d.Set("name", queue.Name)
+	if v := flattenConnectOutboundCallerConfig(queue.OutboundCallerConfig); len(v) > 0 {
+		if err := d.Set("outbound_caller_config", []interface{}{v}); err != nil {
+			return diag.Errorf("error setting outbound_caller_config: %s", err)
+		}
+	} else {
+		d.Set("outbound_caller_config", nil)
+	}
+	d.Set("queue_id", queue.QueueId)
+	d.Set("status", queue.Status)
+
+	quickConnectIDs, err := finder.QueueQuickConnectIDsByID(ctx, conn, instanceID, queueID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Queue (%s) quick connects: %s", d.Id(), err)
+	}
+
+	d.Set("quick_connect_ids", quickConnectIDs)
+
+	tags := keyvaluetags.ConnectKeyValueTags(queue.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, queueID, err := tfconnect.QueueParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateQueueNameInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(d.Get("name").(string)),
+			QueueId:    aws.String(queueID),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Connect Queue name: %s", input)
+		_, err := conn.UpdateQueueNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) name: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("hours_of_operation_id") {
+		input := &connect.UpdateQueueHoursOfOperationInput{
+			HoursOfOperationId: aws.String(d.Get("hours_of_operation_id").(string)),
+			InstanceId:         aws.String(instanceID),
+			QueueId:            aws.String(queueID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Queue hours of operation: %s", input)
+		_, err := conn.UpdateQueueHoursOfOperationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) hours of operation: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("max_contacts") {
+		input := &connect.UpdateQueueMaxContactsInput{
+			InstanceId: aws.String(instanceID),
+			QueueId:    aws.String(queueID),
+		}
+
+		if v, ok := d.GetOk("max_contacts"); ok {
+			input.MaxContacts = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Connect Queue max contacts: %s", input)
+		_, err := conn.UpdateQueueMaxContactsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) max contacts: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("outbound_caller_config") {
+		input := &connect.UpdateQueueOutboundCallerConfigInput{
+			InstanceId:           aws.String(instanceID),
+			OutboundCallerConfig: &connect.OutboundCallerConfig{},
+			QueueId:              aws.String(queueID),
+		}
+
+		if v, ok := d.GetOk("outbound_caller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OutboundCallerConfig = expandConnectOutboundCallerConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Connect Queue outbound caller config: %s", input)
+		_, err := conn.UpdateQueueOutboundCallerConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) outbound caller config: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("quick_connect_ids") {
+		o, n := d.GetChange("quick_connect_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &connect.DisassociateQueueQuickConnectsInput{
+				InstanceId:      aws.String(instanceID),
+				QueueId:         aws.String(queueID),
+				QuickConnectIds: expandStringSet(del),
+			}
+
+			log.Printf("[DEBUG] Disassociating Connect Queue quick connects: %s", input)
+			_, err := conn.DisassociateQueueQuickConnectsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error disassociating Connect Queue (%s) quick connects: %s", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &connect.AssociateQueueQuickConnectsInput{
+				InstanceId:      aws.String(instanceID),
+				QueueId:         aws.String(queueID),
+				QuickConnectIds: expandStringSet(add),
+			}
+
+			log.Printf("[DEBUG] Associating Connect Queue quick connects: %s", input)
+			_, err := conn.AssociateQueueQuickConnectsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error associating Connect Queue (%s) quick connects: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := resourceAwsConnectQueueUpdateStatus(ctx, conn, instanceID, queueID, d.Get("status").(string)); err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Connect Queue (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectQueueRead(ctx, d, meta)
+}
+
+func resourceAwsConnectQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Connect API does not support deleting queues.
+	log.Printf("[WARN] Connect Queue (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func resourceAwsConnectQueueUpdateStatus(ctx context.Context, conn *connect.Connect, instanceID, queueID, status string) error {
+	input := &connect.UpdateQueueStatusInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+		Status:     aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Connect Queue status: %s", input)
+	_, err := conn.UpdateQueueStatusWithContext(ctx, input)
+
+	return err
+}
+
+func expandConnectOutboundCallerConfig(tfMap map[string]interface{}) *connect.OutboundCallerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &connect.OutboundCallerConfig{}
+
+	if v, ok := tfMap["outbound_caller_id_name"].(string); ok && v != "" {
+		apiObject.OutboundCallerIdName = aws.String(v)
+	}
+
+	if v, ok := tfMap["outbound_caller_id_number_id"].(string); ok && v != "" {
+		apiObject.OutboundCallerIdNumberId = aws.String(v)
+	}
+
+	if v, ok := tfMap["outbound_flow_id"].(string); ok && v != "" {
+		apiObject.OutboundFlowId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenConnectOutboundCallerConfig(apiObject *connect.OutboundCallerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OutboundCallerIdName; v != nil {
+		tfMap["outbound_caller_id_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutboundCallerIdNumberId; v != nil {
+		tfMap["outbound_caller_id_number_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutboundFlowId; v != nil {
+		tfMap["outbound_flow_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,481 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsConnectRoutingProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsConnectRoutingProfileCreate,
+		ReadContext:   resourceAwsConnectRoutingProfileRead,
+		UpdateContext: resourceAwsConnectRoutingProfileUpdate,
+		DeleteContext: resourceAwsConnectRoutingProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_outbound_queue_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"media_concurrencies": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"queue_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"delay": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 9999),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"queue_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsConnectRoutingProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateRoutingProfileInput{
+		DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+		InstanceId:             aws.String(instanceID),
+		MediaConcurrencies:     expandConnectMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+		Name:                   aws.String(name),
+	}
+
+	if v, ok := d.GetOk("queue_configs"); ok && v.(*schema.Set).Len() > 0 {
+		input.QueueConfigs = expandConnectRoutingProfileQueueConfigs(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().ConnectTags()
+	}
+
+	log.Printf("[DEBUG] Creating Connect Routing Profile: %s", input)
+	output, err := conn.CreateRoutingProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Connect Routing Profile (%s): %s", name, err)
+	}
+
+	d.SetId(tfconnect.RoutingProfileCreateResourceID(instanceID, aws.StringValue(output.RoutingProfileId)))
+
+	return resourceAwsConnectRoutingProfileRead(ctx, d, meta)
+}
+
+func resourceAwsConnectRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	instanceID, routingProfileID, err := tfconnect.RoutingProfileParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routingProfile, err := finder.RoutingProfileByID(ctx, conn, instanceID, routingProfileID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Routing Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Routing Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", routingProfile.RoutingProfileArn)
+	d.Set("default_outbound_queue_id", routingProfile.DefaultOutboundQueueId)
+	d.Set("description", routingProfile.Description)
+	d.Set("instance_id", instanceID)
+	if err := d.Set("media_concurrencies", flattenConnectMediaConcurrencies(routingProfile.MediaConcurrencies)); err != nil {
+		return diag.Errorf("error setting media_concurrencies: %s", err)
+	}
+	d.Set("name", routingProfile.Name)
+	d.Set("routing_profile_id", routingProfile.RoutingProfileId)
+
+	queueConfigs, err := finder.RoutingProfileQueueConfigsByID(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.Errorf("error reading Connect Routing Profile (%s) queues: %s", d.Id(), err)
+	}
+
+	if err := d.Set("queue_configs", flattenConnectRoutingProfileQueueConfigSummaries(queueConfigs)); err != nil {
+		return diag.Errorf("error setting queue_configs: %s", err)
+	}
+
+	tags := keyvaluetags.ConnectKeyValueTags(routingProfile.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConnectRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).connectconn
+
+	instanceID, routingProfileID, err := tfconnect.RoutingProfileParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &connect.UpdateRoutingProfileNameInput{
+			Description:      aws.String(d.Get("description").(string)),
+			InstanceId:       aws.String(instanceID),
+			Name:             aws.String(d.Get("name").(string)),
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Routing Profile name: %s", input)
+		_, err := conn.UpdateRoutingProfileNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Routing Profile (%s) name: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("default_outbound_queue_id") {
+		input := &connect.UpdateRoutingProfileDefaultOutboundQueueInput{
+			DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+			InstanceId:             aws.String(instanceID),
+			RoutingProfileId:       aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Routing Profile default outbound queue: %s", input)
+		_, err := conn.UpdateRoutingProfileDefaultOutboundQueueWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Routing Profile (%s) default outbound queue: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("media_concurrencies") {
+		input := &connect.UpdateRoutingProfileConcurrencyInput{
+			InstanceId:         aws.String(instanceID),
+			MediaConcurrencies: expandConnectMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+			RoutingProfileId:   aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Routing Profile concurrency: %s", input)
+		_, err := conn.UpdateRoutingProfileConcurrencyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Connect Routing Profile (%s) concurrency: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("queue_configs") {
+		o, n := d.GetChange("queue_configs")
+
+		if err := resourceAwsConnectRoutingProfileUpdateQueueConfigs(ctx, conn, instanceID, routingProfileID, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.Errorf("error updating Connect Routing Profile (%s) queues: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ConnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Connect Routing Profile (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsConnectRoutingProfileRead(ctx, d, meta)
+}
+
+func resourceAwsConnectRoutingProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Connect API does not support deleting routing profiles.
+	log.Printf("[WARN] Connect Routing Profile (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+// resourceAwsConnectRoutingProfileUpdateQueueConfigs reconciles the queues associated with a routing profile.
+// Queues are identified by channel and queue ID; only the delay and priority of an existing association can be updated in place.
+func resourceAwsConnectRoutingProfileUpdateQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, oldList, newList []interface{}) error {
+	oldConfigs := connectRoutingProfileQueueConfigsByReference(expandConnectRoutingProfileQueueConfigs(oldList))
+	newConfigs := connectRoutingProfileQueueConfigsByReference(expandConnectRoutingProfileQueueConfigs(newList))
+
+	var del []*connect.RoutingProfileQueueReference
+	var add, update []*connect.RoutingProfileQueueConfig
+
+	for k, o := range oldConfigs {
+		n, ok := newConfigs[k]
+
+		if !ok {
+			del = append(del, o.QueueReference)
+			continue
+		}
+
+		if aws.Int64Value(o.Delay) != aws.Int64Value(n.Delay) || aws.Int64Value(o.Priority) != aws.Int64Value(n.Priority) {
+			update = append(update, n)
+		}
+	}
+
+	for k, n := range newConfigs {
+		if _, ok := oldConfigs[k]; !ok {
+			add = append(add, n)
+		}
+	}
+
+	if len(del) > 0 {
+		input := &connect.DisassociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueReferences:  del,
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Disassociating Connect Routing Profile queues: %s", input)
+		if _, err := conn.DisassociateRoutingProfileQueuesWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	if len(add) > 0 {
+		input := &connect.AssociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     add,
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Associating Connect Routing Profile queues: %s", input)
+		if _, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	if len(update) > 0 {
+		input := &connect.UpdateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     update,
+			RoutingProfileId: aws.String(routingProfileID),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Routing Profile queues: %s", input)
+		if _, err := conn.UpdateRoutingProfileQueuesWithContext(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func connectRoutingProfileQueueConfigsByReference(apiObjects []*connect.RoutingProfileQueueConfig) map[string]*connect.RoutingProfileQueueConfig {
+	m := make(map[string]*connect.RoutingProfileQueueConfig, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.QueueReference == nil {
+			continue
+		}
+
+		m[aws.StringValue(apiObject.QueueReference.Channel)+"/"+aws.StringValue(apiObject.QueueReference.QueueId)] = apiObject
+	}
+
+	return m
+}
+
+func expandConnectMediaConcurrencies(tfList []interface{}) []*connect.MediaConcurrency {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*connect.MediaConcurrency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.MediaConcurrency{}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = aws.String(v)
+		}
+
+		if v, ok := tfMap["concurrency"].(int); ok {
+			apiObject.Concurrency = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConnectMediaConcurrencies(apiObjects []*connect.MediaConcurrency) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":     aws.StringValue(apiObject.Channel),
+			"concurrency": aws.Int64Value(apiObject.Concurrency),
+		})
+	}
+
+	return tfList
+}
+
+func expandConnectRoutingProfileQueueConfigs(tfList []interface{}) []*connect.RoutingProfileQueueConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*connect.RoutingProfileQueueConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.RoutingProfileQueueConfig{
+			QueueReference: &connect.RoutingProfileQueueReference{},
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.QueueReference.Channel = aws.String(v)
+		}
+
+		if v, ok := tfMap["delay"].(int); ok {
+			apiObject.Delay = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["priority"].(int); ok {
+			apiObject.Priority = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["queue_id"].(string); ok && v != "" {
+			apiObject.QueueReference.QueueId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConnectRoutingProfileQueueConfigSummaries(apiObjects []*connect.RoutingProfileQueueConfigSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":  aws.StringValue(apiObject.Channel),
+			"delay":    aws.Int64Value(apiObject.Delay),
+			"priority": aws.Int64Value(apiObject.Priority),
+			"queue_id": aws.StringValue(apiObject.QueueId),
+		})
+	}
+
+	return tfList
+}
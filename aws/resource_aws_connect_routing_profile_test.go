@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfconnect "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/connect/finder"
+)
+
+func TestAccAWSConnectRoutingProfile_basic(t *testing.T) {
+	var v connect.RoutingProfile
+	resourceName := "aws_connect_routing_profile.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, connect.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSConnectInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSConnectRoutingProfileConfig(rName, "Original", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectRoutingProfileExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "connect", regexp.MustCompile(`instance/.+/routing-profile/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_outbound_queue_id", "aws_connect_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Original"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":     connect.ChannelVoice,
+						"concurrency": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "2",
+						"priority": "1",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSConnectRoutingProfileConfig(rName, "Updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSConnectRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "2",
+						"priority": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSConnectRoutingProfileExists(n string, v *connect.RoutingProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Connect Routing Profile ID is set")
+		}
+
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).connectconn
+
+		output, err := finder.RoutingProfileByID(context.Background(), conn, instanceID, routingProfileID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSConnectRoutingProfileConfig(rName, description string, priority int) string {
+	return composeConfig(
+		testAccAWSConnectQueueConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  hours_of_operation_id = aws_connect_hours_of_operation.test.hours_of_operation_id
+}
+
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  default_outbound_queue_id = aws_connect_queue.test.queue_id
+  description               = %[2]q
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+
+  queue_configs {
+    channel  = "VOICE"
+    delay    = 2
+    priority = %[3]d
+    queue_id = aws_connect_queue.test.queue_id
+  }
+}
+`, rName, description, priority))
+}
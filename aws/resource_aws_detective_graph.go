@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsDetectiveGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsDetectiveGraphCreate,
+		ReadContext:   resourceAwsDetectiveGraphRead,
+		UpdateContext: resourceAwsDetectiveGraphUpdate,
+		DeleteContext: resourceAwsDetectiveGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsDetectiveGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &detective.CreateGraphInput{}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().DetectiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating Detective Graph: %s", input)
+	output, err := conn.CreateGraphWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Detective Graph: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.GraphArn))
+
+	return resourceAwsDetectiveGraphRead(ctx, d, meta)
+}
+
+func resourceAwsDetectiveGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	graph, err := finder.GraphByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Graph (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Graph (%s): %s", d.Id(), err)
+	}
+
+	if graph.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("graph_arn", graph.Arn)
+
+	tags, err := keyvaluetags.DetectiveListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Detective Graph (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDetectiveGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.DetectiveUpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Detective Graph (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDetectiveGraphRead(ctx, d, meta)
+}
+
+func resourceAwsDetectiveGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Deleting Detective Graph: %s", d.Id())
+	_, err := conn.DeleteGraphWithContext(ctx, &detective.DeleteGraphInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Detective Graph (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsDetectiveInvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsDetectiveInvitationAccepterCreate,
+		ReadContext:   resourceAwsDetectiveInvitationAccepterRead,
+		DeleteContext: resourceAwsDetectiveInvitationAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveInvitationAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN := d.Get("graph_arn").(string)
+	input := &detective.AcceptInvitationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	log.Printf("[DEBUG] Accepting Detective Invitation: %s", input)
+	_, err := conn.AcceptInvitationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error accepting Detective Invitation (%s): %s", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return resourceAwsDetectiveInvitationAccepterRead(ctx, d, meta)
+}
+
+func resourceAwsDetectiveInvitationAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	invitation, err := finder.InvitationByGraphARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Invitation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Invitation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("graph_arn", invitation.GraphArn)
+
+	return nil
+}
+
+func resourceAwsDetectiveInvitationAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	log.Printf("[DEBUG] Disassociating from Detective Graph: %s", d.Id())
+	_, err := conn.DisassociateMembershipWithContext(ctx, &detective.DisassociateMembershipInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error disassociating from Detective Graph (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
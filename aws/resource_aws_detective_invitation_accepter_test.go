@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsDetectiveInvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+	graphResourceName := "aws_detective_graph.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveMemberEmail, EnvVarDetectiveMemberEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationAccepterConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", graphResourceName, "graph_arn"),
+				),
+			},
+			{
+				Config:            testAccAwsDetectiveInvitationAccepterConfig(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveInvitationAccepter_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveMemberEmail, EnvVarDetectiveMemberEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveInvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveInvitationAccepterConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveInvitationAccepterExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDetectiveInvitationAccepter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveInvitationAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_invitation_accepter" {
+			continue
+		}
+
+		_, err := finder.InvitationByGraphARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective Invitation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDetectiveInvitationAccepterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective Invitation ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		_, err := finder.InvitationByGraphARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsDetectiveInvitationAccepterConfig(email string) string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {}
+
+resource "aws_detective_graph" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_member" "test" {
+  provider = "awsalternate"
+
+  account_id    = data.aws_caller_identity.member.account_id
+  email_address = %[1]q
+  graph_arn     = aws_detective_graph.test.graph_arn
+}
+
+resource "aws_detective_invitation_accepter" "test" {
+  graph_arn = aws_detective_member.test.graph_arn
+}
+`, email))
+}
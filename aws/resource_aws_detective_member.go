@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsDetectiveMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsDetectiveMemberCreate,
+		ReadContext:   resourceAwsDetectiveMemberRead,
+		DeleteContext: resourceAwsDetectiveMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.MemberInvitedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDetectiveMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	accountID := d.Get("account_id").(string)
+	graphARN := d.Get("graph_arn").(string)
+	id := tfdetective.MemberCreateResourceID(graphARN, accountID)
+
+	input := &detective.CreateMembersInput{
+		Accounts: []*detective.Account{{
+			AccountId:    aws.String(accountID),
+			EmailAddress: aws.String(d.Get("email_address").(string)),
+		}},
+		GraphArn: aws.String(graphARN),
+	}
+
+	if v, ok := d.GetOk("disable_email_notification"); ok {
+		input.DisableEmailNotification = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Detective Member: %s", input)
+	output, err := conn.CreateMembersWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Detective Member (%s): %s", id, err)
+	}
+
+	// {"UnprocessedAccounts":[{"AccountId":"123456789012","Reason":"The account is already a member of the behavior graph."}]}
+	if output != nil && len(output.UnprocessedAccounts) > 0 && output.UnprocessedAccounts[0] != nil {
+		return diag.Errorf("error creating Detective Member (%s): %s", id, aws.StringValue(output.UnprocessedAccounts[0].Reason))
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.MemberInvited(ctx, conn, graphARN, accountID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Detective Member (%s) invite: %s", d.Id(), err)
+	}
+
+	return resourceAwsDetectiveMemberRead(ctx, d, meta)
+}
+
+func resourceAwsDetectiveMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := tfdetective.MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := finder.MemberByTwoPartKey(ctx, conn, graphARN, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Member (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("administrator_id", member.MasterId)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email_address", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	if member.InvitedTime != nil {
+		d.Set("invited_time", aws.TimeValue(member.InvitedTime).Format(time.RFC3339))
+	} else {
+		d.Set("invited_time", nil)
+	}
+	d.Set("status", member.Status)
+	if member.UpdatedTime != nil {
+		d.Set("updated_time", aws.TimeValue(member.UpdatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("updated_time", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsDetectiveMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).detectiveconn
+
+	graphARN, accountID, err := tfdetective.MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Detective Member: %s", d.Id())
+	output, err := conn.DeleteMembersWithContext(ctx, &detective.DeleteMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Detective Member (%s): %s", d.Id(), err)
+	}
+
+	if output != nil && len(output.UnprocessedAccounts) > 0 && output.UnprocessedAccounts[0] != nil {
+		return diag.Errorf("error deleting Detective Member (%s): %s", d.Id(), aws.StringValue(output.UnprocessedAccounts[0].Reason))
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	tfdetective "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/detective/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	EnvVarDetectiveMemberEmail             = "AWS_DETECTIVE_MEMBER_EMAIL"
+	EnvVarDetectiveMemberEmailMessageError = "Environment variable AWS_DETECTIVE_MEMBER_EMAIL is not set. " +
+		"To properly test inviting Detective member accounts, " +
+		"a valid email associated with the alternate account must be provided."
+)
+
+func testAccAwsDetectiveMember_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_member.test"
+	graphResourceName := "aws_detective_graph.test"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveMemberEmail, EnvVarDetectiveMemberEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceAlternate, "account_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "administrator_id"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", email),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", graphResourceName, "graph_arn"),
+					testAccCheckResourceAttrRfc3339(resourceName, "invited_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusInvited),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification", "message"},
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveMember_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_member.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveMemberEmail, EnvVarDetectiveMemberEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDetectiveMember(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsDetectiveMember_Message(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_member.test"
+	email := envvar.TestSkipIfEmpty(t, EnvVarDetectiveMemberEmail, EnvVarDetectiveMemberEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ErrorCheck:        testAccErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: testAccProviderFactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckAwsDetectiveMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsDetectiveMemberConfigMessage(email, "This is a message of the invitation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDetectiveMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disable_email_notification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "message", "This is a message of the invitation"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification", "message"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDetectiveMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_member" {
+			continue
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.MemberByTwoPartKey(context.Background(), conn, graphARN, accountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective Member %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDetectiveMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective Member ID is set")
+		}
+
+		graphARN, accountID, err := tfdetective.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).detectiveconn
+
+		_, err = finder.MemberByTwoPartKey(context.Background(), conn, graphARN, accountID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsDetectiveMemberConfigBase() string {
+	return composeConfig(testAccAlternateAccountProviderConfig(), `
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "test" {}
+`)
+}
+
+func testAccAwsDetectiveMemberConfig(email string) string {
+	return composeConfig(testAccAwsDetectiveMemberConfigBase(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id    = data.aws_caller_identity.member.account_id
+  email_address = %[1]q
+  graph_arn     = aws_detective_graph.test.graph_arn
+}
+`, email))
+}
+
+func testAccAwsDetectiveMemberConfigMessage(email, message string) string {
+	return composeConfig(testAccAwsDetectiveMemberConfigBase(), fmt.Sprintf(`
+resource "aws_detective_member" "test" {
+  account_id                 = data.aws_caller_identity.member.account_id
+  email_address              = %[1]q
+  graph_arn                  = aws_detective_graph.test.graph_arn
+  message                    = %[2]q
+  disable_email_notification = true
+}
+`, email, message))
+}
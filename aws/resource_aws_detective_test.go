@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSDetective_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Graph": {
+			"basic":      testAccAwsDetectiveGraph_basic,
+			"disappears": testAccAwsDetectiveGraph_disappears,
+			"tags":       testAccAwsDetectiveGraph_Tags,
+		},
+		"InvitationAccepter": {
+			"basic":      testAccAwsDetectiveInvitationAccepter_basic,
+			"disappears": testAccAwsDetectiveInvitationAccepter_disappears,
+		},
+		"Member": {
+			"basic":      testAccAwsDetectiveMember_basic,
+			"disappears": testAccAwsDetectiveMember_disappears,
+			"message":    testAccAwsDetectiveMember_Message,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
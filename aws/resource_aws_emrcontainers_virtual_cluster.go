@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsEMRContainersVirtualCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsEMRContainersVirtualClusterCreate,
+		ReadContext:   resourceAwsEMRContainersVirtualClusterRead,
+		UpdateContext: resourceAwsEMRContainersVirtualClusterUpdate,
+		DeleteContext: resourceAwsEMRContainersVirtualClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(waiter.VirtualClusterDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_provider": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"info": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eks_info": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespace": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(emrcontainers.ContainerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[.\-_/#A-Za-z0-9]+$`), "must contain only alphanumeric, hyphen, underscore, dot and # characters"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsEMRContainersVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).emrcontainersconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateVirtualClusterInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("container_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ContainerProvider = expandEMRContainersContainerProvider(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().EmrcontainersTags()
+	}
+
+	log.Printf("[DEBUG] Creating EMR containers virtual cluster: %s", input)
+	output, err := conn.CreateVirtualClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating EMR containers virtual cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsEMRContainersVirtualClusterRead(ctx, d, meta)
+}
+
+func resourceAwsEMRContainersVirtualClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).emrcontainersconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	vc, err := finder.VirtualClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR containers virtual cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EMR containers virtual cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", vc.Arn)
+	if vc.ContainerProvider != nil {
+		if err := d.Set("container_provider", []interface{}{flattenEMRContainersContainerProvider(vc.ContainerProvider)}); err != nil {
+			return diag.Errorf("error setting container_provider: %s", err)
+		}
+	} else {
+		d.Set("container_provider", nil)
+	}
+	d.Set("name", vc.Name)
+
+	tags := keyvaluetags.EmrcontainersKeyValueTags(vc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEMRContainersVirtualClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).emrcontainersconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.EmrcontainersUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating EMR containers virtual cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEMRContainersVirtualClusterRead(ctx, d, meta)
+}
+
+func resourceAwsEMRContainersVirtualClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).emrcontainersconn
+
+	log.Printf("[DEBUG] Deleting EMR containers virtual cluster: %s", d.Id())
+	_, err := conn.DeleteVirtualClusterWithContext(ctx, &emrcontainers.DeleteVirtualClusterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EMR containers virtual cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.VirtualClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EMR containers virtual cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEMRContainersContainerProvider(tfMap map[string]interface{}) *emrcontainers.ContainerProvider {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerProvider{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Info = expandEMRContainersContainerInfo(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEMRContainersContainerInfo(tfMap map[string]interface{}) *emrcontainers.ContainerInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerInfo{}
+
+	if v, ok := tfMap["eks_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EksInfo = expandEMRContainersEksInfo(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEMRContainersEksInfo(tfMap map[string]interface{}) *emrcontainers.EksInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.EksInfo{}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEMRContainersContainerProvider(apiObject *emrcontainers.ContainerProvider) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Info; v != nil {
+		tfMap["info"] = []interface{}{flattenEMRContainersContainerInfo(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenEMRContainersContainerInfo(apiObject *emrcontainers.ContainerInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EksInfo; v != nil {
+		tfMap["eks_info"] = []interface{}{flattenEMRContainersEksInfo(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEMRContainersEksInfo(apiObject *emrcontainers.EksInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Namespace; v != nil {
+		tfMap["namespace"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
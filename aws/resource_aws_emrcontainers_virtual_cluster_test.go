@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/emrcontainers/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	EnvVarEMRContainersEksClusterName             = "EMR_CONTAINERS_EKS_CLUSTER_NAME"
+	EnvVarEMRContainersEksClusterNameMessageError = "Environment variable EMR_CONTAINERS_EKS_CLUSTER_NAME is not set. " +
+		"To properly test EMR on EKS virtual clusters, the name of an EKS cluster " +
+		"with cluster access enabled for Amazon EMR on EKS must be provided."
+
+	EnvVarEMRContainersEksNamespace             = "EMR_CONTAINERS_EKS_NAMESPACE"
+	EnvVarEMRContainersEksNamespaceMessageError = "Environment variable EMR_CONTAINERS_EKS_NAMESPACE is not set. " +
+		"To properly test EMR on EKS virtual clusters, the name of a Kubernetes namespace " +
+		"registered with Amazon EMR on EKS must be provided."
+)
+
+func TestAccAWSEMRContainersVirtualCluster_basic(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	eksClusterName := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksClusterName, EnvVarEMRContainersEksClusterNameMessageError)
+	namespace := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksNamespace, EnvVarEMRContainersEksNamespaceMessageError)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigBasic(rName, eksClusterName, namespace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "emr-containers", regexp.MustCompile(`/virtualclusters/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.id", eksClusterName),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.0.namespace", namespace),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.type", emrcontainers.ContainerProviderTypeEks),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRContainersVirtualCluster_disappears(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	eksClusterName := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksClusterName, EnvVarEMRContainersEksClusterNameMessageError)
+	namespace := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksNamespace, EnvVarEMRContainersEksNamespaceMessageError)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigBasic(rName, eksClusterName, namespace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEMRContainersVirtualCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEMRContainersVirtualCluster_Tags(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	eksClusterName := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksClusterName, EnvVarEMRContainersEksClusterNameMessageError)
+	namespace := envvar.TestSkipIfEmpty(t, EnvVarEMRContainersEksNamespace, EnvVarEMRContainersEksNamespaceMessageError)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEMRContainersVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, namespace, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags2(rName, eksClusterName, namespace, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, namespace, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEMRContainersVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEMRContainersVirtualClusterExists(n string, v *emrcontainers.VirtualCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR containers virtual cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).emrcontainersconn
+
+		output, err := finder.VirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEMRContainersVirtualClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).emrcontainersconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_virtual_cluster" {
+			continue
+		}
+
+		_, err := finder.VirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR containers virtual cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEMRContainersVirtualClusterConfigBasic(rName, eksClusterName, namespace string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = %[3]q
+      }
+    }
+  }
+}
+`, rName, eksClusterName, namespace)
+}
+
+func testAccAWSEMRContainersVirtualClusterConfigTags1(rName, eksClusterName, namespace, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = %[3]q
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, eksClusterName, namespace, tagKey1, tagValue1)
+}
+
+func testAccAWSEMRContainersVirtualClusterConfigTags2(rName, eksClusterName, namespace, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = %[3]q
+      }
+    }
+  }
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, eksClusterName, namespace, tagKey1, tagValue1, tagKey2, tagValue2)
+}
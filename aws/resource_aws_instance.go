@@ -595,7 +595,8 @@ func resourceAwsInstance() *schema.Resource {
 					return
 				},
 			},
-			"volume_tags": tagsSchema(),
+			"volume_tags":     tagsSchema(),
+			"volume_tags_all": tagsSchemaComputed(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -638,6 +639,14 @@ func resourceAwsInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			SetTagsDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Block device tags are managed per volume instead.
+				if blockDeviceTagsDefined(diff) {
+					return nil
+				}
+
+				return setTagsAllDiff(diff, meta, "volume_tags", "volume_tags_all")
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				_, ok := diff.GetOk("launch_template")
 
@@ -721,7 +730,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
-	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromMap(d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
+	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromMap(d.Get("volume_tags_all").(map[string]interface{}), ec2.ResourceTypeVolume)...)
 
 	// Build the creation struct
 	runOpts := &ec2.RunInstancesInput{
@@ -1082,15 +1091,21 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
-	if _, ok := d.GetOk("volume_tags"); ok && !blockDeviceTagsDefined(d) {
+	if _, ok := d.GetOk("volume_tags"); (ok || len(defaultTagsConfig.GetTags()) > 0) && !blockDeviceTagsDefined(d) {
 		volumeTags, err := readVolumeTags(conn, d.Id())
 		if err != nil {
 			return err
 		}
 
-		if err := d.Set("volume_tags", keyvaluetags.Ec2KeyValueTags(volumeTags).IgnoreAws().Map()); err != nil {
+		tags := keyvaluetags.Ec2KeyValueTags(volumeTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 			return fmt.Errorf("error setting volume_tags: %s", err)
 		}
+
+		if err := d.Set("volume_tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting volume_tags_all: %w", err)
+		}
 	}
 
 	if err := readSecurityGroups(d, instance, conn); err != nil {
@@ -1198,13 +1213,13 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("volume_tags") && !d.IsNewResource() {
+	if d.HasChange("volume_tags_all") && !d.IsNewResource() {
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d.Id())
 		if err != nil {
 			return err
 		}
 
-		o, n := d.GetChange("volume_tags")
+		o, n := d.GetChange("volume_tags_all")
 
 		for _, volumeId := range volumeIds {
 			if err := keyvaluetags.Ec2UpdateTags(conn, volumeId, o, n); err != nil {
@@ -2017,7 +2032,7 @@ func readBlockDevicesFromInstance(d *schema.ResourceData, instance *ec2.Instance
 		if instanceBd.DeviceName != nil {
 			bd["device_name"] = aws.StringValue(instanceBd.DeviceName)
 		}
-		if v, ok := d.GetOk("volume_tags"); (!ok || v == nil || len(v.(map[string]interface{})) == 0) && vol.Tags != nil {
+		if v, ok := d.GetOk("volume_tags_all"); (!ok || v == nil || len(v.(map[string]interface{})) == 0) && vol.Tags != nil {
 			bd["tags"] = keyvaluetags.Ec2KeyValueTags(vol.Tags).IgnoreAws().Map()
 		}
 
@@ -2864,7 +2879,11 @@ func getVolumeIdByDeviceName(instance *ec2.Instance, deviceName string) string {
 	return volumeId
 }
 
-func blockDeviceTagsDefined(d *schema.ResourceData) bool {
+// blockDeviceTagsDefined returns whether any root or EBS block device has tags configured.
+// It accepts either a *schema.ResourceData or a *schema.ResourceDiff.
+func blockDeviceTagsDefined(d interface {
+	GetOk(string) (interface{}, bool)
+}) bool {
 	if v, ok := d.GetOk("root_block_device"); ok {
 		vL := v.([]interface{})
 		for _, v := range vL {
@@ -628,6 +628,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 		// Enable downstream updates for resources referencing schema attributes
 		// to prevent non-empty plans after "terraform apply"
 		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
@@ -650,7 +651,6 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
-			SetTagsDiff,
 		),
 	}
 }
@@ -662,7 +662,7 @@ func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) e
 
 	ltName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
-	launchTemplateData, err := buildLaunchTemplateData(d)
+	launchTemplateData, err := buildLaunchTemplateData(d, defaultTagsConfig)
 	if err != nil {
 		return err
 	}
@@ -850,7 +850,25 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting hibernation_options: %s", err)
 	}
 
-	if err := d.Set("tag_specifications", getTagSpecifications(ltData.TagSpecifications)); err != nil {
+	tagSpecifications := getTagSpecifications(ltData.TagSpecifications)
+	configuredTagSpecifications := d.Get("tag_specifications").([]interface{})
+
+	// Provider default tags merged into each tag specification are not shown,
+	// unless explicitly configured with the same value in the tag specification.
+	for i, v := range tagSpecifications {
+		tagSpecification := v.(map[string]interface{})
+		tags := keyvaluetags.New(tagSpecification["tags"])
+		resourceTags := tags.RemoveDefaultConfig(defaultTagsConfig)
+
+		if i < len(configuredTagSpecifications) && configuredTagSpecifications[i] != nil {
+			configuredTags := keyvaluetags.New(configuredTagSpecifications[i].(map[string]interface{})["tags"])
+			resourceTags = resourceTags.Merge(tags.Only(configuredTags))
+		}
+
+		tagSpecification["tags"] = resourceTags.Map()
+	}
+
+	if err := d.Set("tag_specifications", tagSpecifications); err != nil {
 		return fmt.Errorf("error setting tag_specifications: %s", err)
 	}
 
@@ -859,12 +877,13 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	latestVersion := int64(d.Get("latest_version").(int))
 	defaultVersion := d.Get("default_version").(int)
 
-	if d.HasChanges(updateKeys...) {
-		launchTemplateData, err := buildLaunchTemplateData(d)
+	if d.HasChanges(updateKeys...) || launchTemplateTagSpecificationsDefaultTagsChanged(d) {
+		launchTemplateData, err := buildLaunchTemplateData(d, defaultTagsConfig)
 		if err != nil {
 			return err
 		}
@@ -1295,7 +1314,23 @@ func getTagSpecifications(t []*ec2.LaunchTemplateTagSpecification) []interface{}
 	return s
 }
 
-func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+// launchTemplateTagSpecificationsDefaultTagsChanged returns whether the provider default tags,
+// which are merged into any tag specifications, have changed.
+func launchTemplateTagSpecificationsDefaultTagsChanged(d *schema.ResourceData) bool {
+	if len(d.Get("tag_specifications").([]interface{})) == 0 {
+		return false
+	}
+
+	oTagsRaw, nTagsRaw := d.GetChange("tags")
+	oTagsAllRaw, nTagsAllRaw := d.GetChange("tags_all")
+
+	oDefaultTags := keyvaluetags.New(oTagsAllRaw).Ignore(keyvaluetags.New(oTagsRaw))
+	nDefaultTags := keyvaluetags.New(nTagsAllRaw).Ignore(keyvaluetags.New(nTagsRaw))
+
+	return !oDefaultTags.Equal(nDefaultTags)
+}
+
+func buildLaunchTemplateData(d *schema.ResourceData, defaultTagsConfig *keyvaluetags.DefaultConfig) (*ec2.RequestLaunchTemplateData, error) {
 	opts := &ec2.RequestLaunchTemplateData{
 		UserData: aws.String(d.Get("user_data").(string)),
 	}
@@ -1500,7 +1535,7 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 			tsData := ts.(map[string]interface{})
 			tagSpecification := &ec2.LaunchTemplateTagSpecificationRequest{
 				ResourceType: aws.String(tsData["resource_type"].(string)),
-				Tags:         keyvaluetags.New(tsData["tags"].(map[string]interface{})).IgnoreAws().Ec2Tags(),
+				Tags:         defaultTagsConfig.MergeTags(keyvaluetags.New(tsData["tags"].(map[string]interface{}))).IgnoreAws().Ec2Tags(),
 			}
 			tagSpecifications = append(tagSpecifications, tagSpecification)
 		}
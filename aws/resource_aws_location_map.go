@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLocationMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsLocationMapCreate,
+		ReadContext:   resourceAwsLocationMapRead,
+		UpdateContext: resourceAwsLocationMapUpdate,
+		DeleteContext: resourceAwsLocationMapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"style": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"map_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"map_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLocationMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("map_name").(string)
+	input := &locationservice.CreateMapInput{
+		Configuration: expandLocationServiceMapConfiguration(d.Get("configuration").([]interface{})[0].(map[string]interface{})),
+		MapName:       aws.String(name),
+		PricingPlan:   aws.String(d.Get("pricing_plan").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().LocationserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Map: %s", input)
+	output, err := conn.CreateMapWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Map (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MapName))
+
+	return resourceAwsLocationMapRead(ctx, d, meta)
+}
+
+func resourceAwsLocationMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.MapByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Map (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Map (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", []interface{}{flattenLocationServiceMapConfiguration(output.Configuration)}); err != nil {
+		return diag.Errorf("error setting configuration: %s", err)
+	}
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("map_arn", output.MapArn)
+	d.Set("map_name", output.MapName)
+	d.Set("pricing_plan", output.PricingPlan)
+	if output.UpdateTime != nil {
+		d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("update_time", nil)
+	}
+
+	tags := keyvaluetags.LocationserviceKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLocationMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	if d.HasChanges("description", "pricing_plan") {
+		input := &locationservice.UpdateMapInput{
+			Description: aws.String(d.Get("description").(string)),
+			MapName:     aws.String(d.Id()),
+			PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Map: %s", input)
+		_, err := conn.UpdateMapWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Map (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.LocationserviceUpdateTags(conn, d.Get("map_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Map (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLocationMapRead(ctx, d, meta)
+}
+
+func resourceAwsLocationMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	log.Printf("[DEBUG] Deleting Location Service Map: %s", d.Id())
+	_, err := conn.DeleteMapWithContext(ctx, &locationservice.DeleteMapInput{
+		MapName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Map (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLocationServiceMapConfiguration(tfMap map[string]interface{}) *locationservice.MapConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.MapConfiguration{}
+
+	if v, ok := tfMap["style"].(string); ok && v != "" {
+		apiObject.Style = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLocationServiceMapConfiguration(apiObject *locationservice.MapConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Style; v != nil {
+		tfMap["style"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
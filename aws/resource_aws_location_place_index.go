@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLocationPlaceIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsLocationPlaceIndexCreate,
+		ReadContext:   resourceAwsLocationPlaceIndexRead,
+		UpdateContext: resourceAwsLocationPlaceIndexUpdate,
+		DeleteContext: resourceAwsLocationPlaceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"data_source_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intended_use": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      locationservice.IntendedUseSingleUse,
+							ValidateFunc: validation.StringInSlice(locationservice.IntendedUse_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"index_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLocationPlaceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("index_name").(string)
+	input := &locationservice.CreatePlaceIndexInput{
+		DataSource:  aws.String(d.Get("data_source").(string)),
+		IndexName:   aws.String(name),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSourceConfiguration = expandLocationServiceDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().LocationserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Place Index: %s", input)
+	output, err := conn.CreatePlaceIndexWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Place Index (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IndexName))
+
+	return resourceAwsLocationPlaceIndexRead(ctx, d, meta)
+}
+
+func resourceAwsLocationPlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.PlaceIndexByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Place Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Place Index (%s): %s", d.Id(), err)
+	}
+
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("data_source", output.DataSource)
+	if output.DataSourceConfiguration != nil {
+		if err := d.Set("data_source_configuration", []interface{}{flattenLocationServiceDataSourceConfiguration(output.DataSourceConfiguration)}); err != nil {
+			return diag.Errorf("error setting data_source_configuration: %s", err)
+		}
+	} else {
+		d.Set("data_source_configuration", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("index_arn", output.IndexArn)
+	d.Set("index_name", output.IndexName)
+	d.Set("pricing_plan", output.PricingPlan)
+	if output.UpdateTime != nil {
+		d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("update_time", nil)
+	}
+
+	tags := keyvaluetags.LocationserviceKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLocationPlaceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	if d.HasChanges("data_source_configuration", "description", "pricing_plan") {
+		input := &locationservice.UpdatePlaceIndexInput{
+			Description: aws.String(d.Get("description").(string)),
+			IndexName:   aws.String(d.Id()),
+			PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		}
+
+		if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DataSourceConfiguration = expandLocationServiceDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Place Index: %s", input)
+		_, err := conn.UpdatePlaceIndexWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Place Index (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.LocationserviceUpdateTags(conn, d.Get("index_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Place Index (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLocationPlaceIndexRead(ctx, d, meta)
+}
+
+func resourceAwsLocationPlaceIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	log.Printf("[DEBUG] Deleting Location Service Place Index: %s", d.Id())
+	_, err := conn.DeletePlaceIndexWithContext(ctx, &locationservice.DeletePlaceIndexInput{
+		IndexName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Place Index (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLocationServiceDataSourceConfiguration(tfMap map[string]interface{}) *locationservice.DataSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.DataSourceConfiguration{}
+
+	if v, ok := tfMap["intended_use"].(string); ok && v != "" {
+		apiObject.IntendedUse = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLocationServiceDataSourceConfiguration(apiObject *locationservice.DataSourceConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IntendedUse; v != nil {
+		tfMap["intended_use"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
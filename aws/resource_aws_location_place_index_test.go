@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSLocationPlaceIndex_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_location_place_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, locationservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLocationPlaceIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLocationPlaceIndexConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "index_arn", "geo", regexp.MustCompile(`place-index/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "index_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_plan", locationservice.PricingPlanRequestBasedUsage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccCheckResourceAttrRfc3339(resourceName, "update_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLocationPlaceIndex_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_location_place_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, locationservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLocationPlaceIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLocationPlaceIndexConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsLocationPlaceIndex(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLocationPlaceIndex_Description(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_location_place_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, locationservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLocationPlaceIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLocationPlaceIndexConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLocationPlaceIndexConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLocationPlaceIndex_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_location_place_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, locationservice.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLocationPlaceIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLocationPlaceIndexConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLocationPlaceIndexConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLocationPlaceIndexConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLocationPlaceIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLocationPlaceIndexDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).locationconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_location_place_index" {
+			continue
+		}
+
+		_, err := finder.PlaceIndexByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Location Service Place Index %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLocationPlaceIndexExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Location Service Place Index ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).locationconn
+
+		_, err := finder.PlaceIndexByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLocationPlaceIndexConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_place_index" "test" {
+  index_name  = %[1]q
+  data_source = "Here"
+}
+`, rName)
+}
+
+func testAccAWSLocationPlaceIndexConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_location_place_index" "test" {
+  index_name  = %[1]q
+  data_source = "Here"
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccAWSLocationPlaceIndexConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_location_place_index" "test" {
+  index_name  = %[1]q
+  data_source = "Here"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSLocationPlaceIndexConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_location_place_index" "test" {
+  index_name  = %[1]q
+  data_source = "Here"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
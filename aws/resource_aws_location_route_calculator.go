@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLocationRouteCalculator() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsLocationRouteCalculatorCreate,
+		ReadContext:   resourceAwsLocationRouteCalculatorRead,
+		UpdateContext: resourceAwsLocationRouteCalculatorUpdate,
+		DeleteContext: resourceAwsLocationRouteCalculatorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"calculator_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"calculator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLocationRouteCalculatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("calculator_name").(string)
+	input := &locationservice.CreateRouteCalculatorInput{
+		CalculatorName: aws.String(name),
+		DataSource:     aws.String(d.Get("data_source").(string)),
+		PricingPlan:    aws.String(d.Get("pricing_plan").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().LocationserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Route Calculator: %s", input)
+	output, err := conn.CreateRouteCalculatorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Route Calculator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CalculatorName))
+
+	return resourceAwsLocationRouteCalculatorRead(ctx, d, meta)
+}
+
+func resourceAwsLocationRouteCalculatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.RouteCalculatorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Route Calculator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Route Calculator (%s): %s", d.Id(), err)
+	}
+
+	d.Set("calculator_arn", output.CalculatorArn)
+	d.Set("calculator_name", output.CalculatorName)
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("data_source", output.DataSource)
+	d.Set("description", output.Description)
+	d.Set("pricing_plan", output.PricingPlan)
+	if output.UpdateTime != nil {
+		d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("update_time", nil)
+	}
+
+	tags := keyvaluetags.LocationserviceKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLocationRouteCalculatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	if d.HasChanges("description", "pricing_plan") {
+		input := &locationservice.UpdateRouteCalculatorInput{
+			CalculatorName: aws.String(d.Id()),
+			Description:    aws.String(d.Get("description").(string)),
+			PricingPlan:    aws.String(d.Get("pricing_plan").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Route Calculator: %s", input)
+		_, err := conn.UpdateRouteCalculatorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Route Calculator (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.LocationserviceUpdateTags(conn, d.Get("calculator_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Route Calculator (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLocationRouteCalculatorRead(ctx, d, meta)
+}
+
+func resourceAwsLocationRouteCalculatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	log.Printf("[DEBUG] Deleting Location Service Route Calculator: %s", d.Id())
+	_, err := conn.DeleteRouteCalculatorWithContext(ctx, &locationservice.DeleteRouteCalculatorInput{
+		CalculatorName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Route Calculator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
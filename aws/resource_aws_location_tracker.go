@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/locationservice/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLocationTracker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsLocationTrackerCreate,
+		ReadContext:   resourceAwsLocationTrackerRead,
+		UpdateContext: resourceAwsLocationTrackerUpdate,
+		DeleteContext: resourceAwsLocationTrackerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"pricing_plan_data_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"tracker_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLocationTrackerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("tracker_name").(string)
+	input := &locationservice.CreateTrackerInput{
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		TrackerName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pricing_plan_data_source"); ok {
+		input.PricingPlanDataSource = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().LocationserviceTags()
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Tracker: %s", input)
+	output, err := conn.CreateTrackerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Tracker (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrackerName))
+
+	return resourceAwsLocationTrackerRead(ctx, d, meta)
+}
+
+func resourceAwsLocationTrackerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.TrackerByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Tracker (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Tracker (%s): %s", d.Id(), err)
+	}
+
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("pricing_plan_data_source", output.PricingPlanDataSource)
+	d.Set("tracker_arn", output.TrackerArn)
+	d.Set("tracker_name", output.TrackerName)
+	if output.UpdateTime != nil {
+		d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("update_time", nil)
+	}
+
+	tags := keyvaluetags.LocationserviceKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLocationTrackerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	if d.HasChanges("description", "pricing_plan", "pricing_plan_data_source") {
+		input := &locationservice.UpdateTrackerInput{
+			Description: aws.String(d.Get("description").(string)),
+			PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+			TrackerName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("pricing_plan_data_source"); ok {
+			input.PricingPlanDataSource = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Tracker: %s", input)
+		_, err := conn.UpdateTrackerWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Tracker (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.LocationserviceUpdateTags(conn, d.Get("tracker_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Tracker (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLocationTrackerRead(ctx, d, meta)
+}
+
+func resourceAwsLocationTrackerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).locationconn
+
+	log.Printf("[DEBUG] Deleting Location Service Tracker: %s", d.Id())
+	_, err := conn.DeleteTrackerWithContext(ctx, &locationservice.DeleteTrackerInput{
+		TrackerName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Tracker (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbAcl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsMemoryDbAclCreate,
+		ReadContext:   resourceAwsMemoryDbAclRead,
+		UpdateContext: resourceAwsMemoryDbAclUpdate,
+		DeleteContext: resourceAwsMemoryDbAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName(40),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix(40),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDbAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateACLInput{
+		ACLName: aws.String(name),
+		Tags:    tags.IgnoreAws().MemorydbTags(),
+	}
+
+	if v, ok := d.GetOk("user_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.UserNames = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB ACL: %s", input)
+	_, err := conn.CreateACLWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MemoryDB ACL (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waiter.ACLActive(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for MemoryDB ACL (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDbAclRead(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("user_names") {
+		o, n := d.GetChange("user_names")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &memorydb.UpdateACLInput{
+			ACLName: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.UserNamesToAdd = expandStringSet(add)
+		}
+
+		// When a user is deleted, MemoryDB removes it from the ACL.
+		// Only remove users that still belong to the ACL.
+		acl, err := finder.ACLByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading MemoryDB ACL (%s): %s", d.Id(), err)
+		}
+
+		existingUserNames := flattenStringSet(acl.UserNames)
+
+		if remove := os.Difference(ns).Intersection(existingUserNames); remove.Len() > 0 {
+			input.UserNamesToRemove = expandStringSet(remove)
+		}
+
+		if len(input.UserNamesToAdd) > 0 || len(input.UserNamesToRemove) > 0 {
+			log.Printf("[DEBUG] Updating MemoryDB ACL (%s)", d.Id())
+			_, err := conn.UpdateACLWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error updating MemoryDB ACL (%s): %s", d.Id(), err)
+			}
+
+			if err := waiter.ACLActive(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("error waiting for MemoryDB ACL (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MemoryDB ACL (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbAclRead(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	acl, err := finder.ACLByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", acl.ARN)
+	d.Set("minimum_engine_version", acl.MinimumEngineVersion)
+	d.Set("name", acl.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(acl.Name)))
+	d.Set("user_names", flattenStringSet(acl.UserNames))
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB ACL: (%s)", d.Id())
+	_, err := conn.DeleteACLWithContext(ctx, &memorydb.DeleteACLInput{
+		ACLName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeACLNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MemoryDB ACL (%s): %s", d.Id(), err)
+	}
+
+	if err := waiter.ACLDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for MemoryDB ACL (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+		Name: "aws_memorydb_acl",
+		F:    testSweepMemoryDbAcls,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+}
+
+func testSweepMemoryDbAcls(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).memorydbconn
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeACLsInput{}
+
+	for {
+		output, err := conn.DescribeACLs(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB ACLs: %w", err))
+			break
+		}
+
+		for _, acl := range output.ACLs {
+			id := aws.StringValue(acl.Name)
+
+			if id == "open-access" {
+				log.Printf("[INFO] Skipping MemoryDB ACL: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting MemoryDB ACL (%s)", id)
+			r := resourceAwsMemoryDbAcl()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB ACLs for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB ACLs sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSMemoryDbAcl_basic(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	user1 := "tf-test-" + acctest.RandString(8)
+	user2 := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, memorydb.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbAclConfig(rName, []string{user1, user2}, []string{user1}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbAclExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "acl/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_names.*", "aws_memorydb_user.test_0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Test", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMemoryDbAclConfig(rName, []string{user1, user2}, []string{user2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbAclExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_names.*", "aws_memorydb_user.test_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbAcl_disappears(t *testing.T) {
+	rName := "tf-test-" + acctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, memorydb.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbAclConfig(rName, nil, nil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbAclExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMemoryDbAcl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMemoryDbAcl_namePrefix(t *testing.T) {
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, memorydb.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMemoryDbAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMemoryDbAclConfig_withNamePrefix("tftest-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMemoryDbAclExists(resourceName),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tftest-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tftest-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMemoryDbAclDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_acl" {
+			continue
+		}
+
+		_, err := finder.ACLByName(context.Background(), conn, rs.Primary.Attributes["name"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB ACL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSMemoryDbAclExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB ACL ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).memorydbconn
+
+		_, err := finder.ACLByName(context.Background(), conn, rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testAccAWSMemoryDbAclConfigUsers(userNames ...string) string {
+	var config string
+
+	for i, userName := range userNames {
+		config += fmt.Sprintf(`
+resource "aws_memorydb_user" "test_%[1]d" {
+  access_string = "on ~* &* +@all"
+  user_name     = %[2]q
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+`, i, userName)
+	}
+
+	return config
+}
+
+func testAccAWSMemoryDbAclConfig(rName string, userNames []string, inAcl []string) string {
+	var userNamesInAcl string
+
+	for _, userName := range inAcl {
+		for i, v := range userNames {
+			if v == userName {
+				userNamesInAcl += fmt.Sprintf("aws_memorydb_user.test_%d.id, ", i)
+			}
+		}
+	}
+
+	return composeConfig(
+		testAccAWSMemoryDbAclConfigUsers(userNames...),
+		fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name       = %[1]q
+  user_names = [%[2]s]
+
+  tags = {
+    Test = "test"
+  }
+}
+`, rName, userNamesInAcl),
+	)
+}
+
+func testAccAWSMemoryDbAclConfig_withNamePrefix(rNamePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name_prefix = %[1]q
+}
+`, rNamePrefix)
+}
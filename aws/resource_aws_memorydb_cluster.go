@@ -0,0 +1,668 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	tfmemorydb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsMemoryDbCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsMemoryDbClusterCreate,
+		ReadContext:   resourceAwsMemoryDbClusterRead,
+		UpdateContext: resourceAwsMemoryDbClusterUpdate,
+		DeleteContext: resourceAwsMemoryDbClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ClusterAvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.ClusterAvailableTimeout),
+			Delete: schema.DefaultTimeout(waiter.ClusterDeletedTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMemoryDbName(40),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"cluster_endpoint": memoryDbEndpointSchema(),
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"engine_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMemoryDbName(255),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceAWeekWindowFormat,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName(40),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix(40),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_replicas_per_shard": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"shards": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nodes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint": memoryDbEndpointSchema(),
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_arns": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"snapshot_name"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_arns"},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"snapshot_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateOnceADayWindowFormat,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func memoryDbEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMemoryDbClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateClusterInput{
+		ACLName:                 aws.String(d.Get("acl_name").(string)),
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		ClusterName:             aws.String(name),
+		NodeType:                aws.String(d.Get("node_type").(string)),
+		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
+		Tags:                    tags.IgnoreAws().MemorydbTags(),
+		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns"); ok && len(v.([]interface{})) > 0 {
+		input.SnapshotArns = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		input.SnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_window"); ok {
+		input.SnapshotWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
+	_, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MemoryDB Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waiter.ClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for MemoryDB Cluster (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsMemoryDbClusterRead(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChangesExcept("final_snapshot_name", "tags", "tags_all") {
+		waitSecurityGroupsActive := false
+
+		input := &memorydb.UpdateClusterInput{
+			ClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("acl_name") {
+			input.ACLName = aws.String(d.Get("acl_name").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("maintenance_window") {
+			input.MaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_replicas_per_shard") {
+			input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
+				ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+			}
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			}
+		}
+
+		if d.HasChange("parameter_group_name") {
+			input.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+		}
+
+		if d.HasChange("security_group_ids") {
+			input.SecurityGroupIds = expandStringSet(d.Get("security_group_ids").(*schema.Set))
+			waitSecurityGroupsActive = true
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("snapshot_window") {
+			input.SnapshotWindow = aws.String(d.Get("snapshot_window").(string))
+		}
+
+		if d.HasChange("sns_topic_arn") {
+			v := d.Get("sns_topic_arn").(string)
+
+			input.SnsTopicArn = aws.String(v)
+
+			if v == "" {
+				input.SnsTopicStatus = aws.String(tfmemorydb.SnsTopicStatusInactive)
+			} else {
+				input.SnsTopicStatus = aws.String(tfmemorydb.SnsTopicStatusActive)
+			}
+		}
+
+		// The API rejects changes to the number of shards and replicas
+		// in the same request as other modifications, so apply them separately.
+		replicaConfiguration, shardConfiguration := input.ReplicaConfiguration, input.ShardConfiguration
+		input.ReplicaConfiguration, input.ShardConfiguration = nil, nil
+
+		requests := []*memorydb.UpdateClusterInput{input}
+
+		if replicaConfiguration != nil {
+			requests = append(requests, &memorydb.UpdateClusterInput{
+				ClusterName:          aws.String(d.Id()),
+				ReplicaConfiguration: replicaConfiguration,
+			})
+		}
+
+		if shardConfiguration != nil {
+			requests = append(requests, &memorydb.UpdateClusterInput{
+				ClusterName:        aws.String(d.Id()),
+				ShardConfiguration: shardConfiguration,
+			})
+		}
+
+		for i, request := range requests {
+			// Skip the general request when only the shard or replica counts changed.
+			if i == 0 && memoryDbClusterUpdateInputIsEmpty(request) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Updating MemoryDB Cluster (%s): %s", d.Id(), request)
+			_, err := conn.UpdateClusterWithContext(ctx, request)
+
+			if err != nil {
+				return diag.Errorf("error updating MemoryDB Cluster (%s): %s", d.Id(), err)
+			}
+
+			if err := waiter.ClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error waiting for MemoryDB Cluster (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		if waitSecurityGroupsActive {
+			if err := waiter.ClusterSecurityGroupsActive(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("error waiting for MemoryDB Cluster (%s) security groups to be available: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MemoryDB Cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbClusterRead(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	cluster, err := finder.ClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("acl_name", cluster.ACLName)
+	d.Set("arn", cluster.ARN)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
+
+	if v := cluster.ClusterEndpoint; v != nil {
+		d.Set("cluster_endpoint", flattenMemoryDbEndpoint(v))
+		d.Set("port", v.Port)
+	}
+
+	d.Set("description", cluster.Description)
+	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("kms_key_arn", cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
+	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("name", cluster.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(cluster.Name)))
+	d.Set("node_type", cluster.NodeType)
+
+	numReplicasPerShard, err := deriveMemoryDbNumReplicasPerShard(cluster)
+
+	if err != nil {
+		return diag.Errorf("error reading num_replicas_per_shard for MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("num_replicas_per_shard", numReplicasPerShard)
+	d.Set("num_shards", cluster.NumberOfShards)
+	d.Set("parameter_group_name", cluster.ParameterGroupName)
+
+	var securityGroupIds []*string
+	for _, v := range cluster.SecurityGroups {
+		securityGroupIds = append(securityGroupIds, v.SecurityGroupId)
+	}
+	d.Set("security_group_ids", flattenStringSet(securityGroupIds))
+
+	if err := d.Set("shards", flattenMemoryDbShards(cluster.Shards)); err != nil {
+		return diag.Errorf("error setting shards: %s", err)
+	}
+
+	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
+	d.Set("snapshot_window", cluster.SnapshotWindow)
+
+	if aws.StringValue(cluster.SnsTopicStatus) == tfmemorydb.SnsTopicStatusActive {
+		d.Set("sns_topic_arn", cluster.SnsTopicArn)
+	} else {
+		d.Set("sns_topic_arn", "")
+	}
+
+	d.Set("subnet_group_name", cluster.SubnetGroupName)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	input := &memorydb.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	if v := d.Get("final_snapshot_name"); v != nil && len(v.(string)) > 0 {
+		input.FinalSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Cluster: (%s)", d.Id())
+	_, err := conn.DeleteClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := waiter.ClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MemoryDB Cluster (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func memoryDbClusterUpdateInputIsEmpty(input *memorydb.UpdateClusterInput) bool {
+	return input.ACLName == nil &&
+		input.Description == nil &&
+		input.EngineVersion == nil &&
+		input.MaintenanceWindow == nil &&
+		input.NodeType == nil &&
+		input.ParameterGroupName == nil &&
+		input.SecurityGroupIds == nil &&
+		input.SnapshotRetentionLimit == nil &&
+		input.SnapshotWindow == nil &&
+		input.SnsTopicArn == nil &&
+		input.SnsTopicStatus == nil
+}
+
+func flattenMemoryDbEndpoint(endpoint *memorydb.Endpoint) []interface{} {
+	if endpoint == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := aws.StringValue(endpoint.Address); v != "" {
+		m["address"] = v
+	}
+
+	if v := aws.Int64Value(endpoint.Port); v != 0 {
+		m["port"] = v
+	}
+
+	return []interface{}{m}
+}
+
+func flattenMemoryDbShards(shards []*memorydb.Shard) *schema.Set {
+	shardSet := schema.NewSet(func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["name"])
+	}, nil)
+
+	for _, shard := range shards {
+		if shard == nil {
+			continue
+		}
+
+		nodeSet := schema.NewSet(func(v interface{}) int {
+			return schema.HashString(v.(map[string]interface{})["name"])
+		}, nil)
+
+		for _, node := range shard.Nodes {
+			if node == nil {
+				continue
+			}
+
+			nodeSet.Add(map[string]interface{}{
+				"availability_zone": aws.StringValue(node.AvailabilityZone),
+				"create_time":       aws.TimeValue(node.CreateTime).Format(time.RFC3339),
+				"endpoint":          flattenMemoryDbEndpoint(node.Endpoint),
+				"name":              aws.StringValue(node.Name),
+			})
+		}
+
+		shardSet.Add(map[string]interface{}{
+			"name":      aws.StringValue(shard.Name),
+			"num_nodes": int(aws.Int64Value(shard.NumberOfNodes)),
+			"nodes":     nodeSet,
+			"slots":     aws.StringValue(shard.Slots),
+		})
+	}
+
+	return shardSet
+}
+
+// deriveMemoryDbNumReplicasPerShard determines the replicas per shard
+// configuration of a cluster. As this value is not directly returned
+// by the API, it is derived by counting the nodes in each shard.
+func deriveMemoryDbNumReplicasPerShard(cluster *memorydb.Cluster) (int, error) {
+	var maxNumberOfNodesPerShard int64
+
+	for _, shard := range cluster.Shards {
+		if n := aws.Int64Value(shard.NumberOfNodes); n > maxNumberOfNodesPerShard {
+			maxNumberOfNodesPerShard = n
+		}
+	}
+
+	if maxNumberOfNodesPerShard == 0 {
+		return 0, fmt.Errorf("no shard nodes found in cluster %s", aws.StringValue(cluster.Name))
+	}
+
+	return int(maxNumberOfNodesPerShard - 1), nil
+}
@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/memorydb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// memoryDbParameterGroupMaxParameters is the maximum number of parameters
+// that can be updated or reset in a single request.
+const memoryDbParameterGroupMaxParameters = 20
+
+func resourceAwsMemoryDbParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsMemoryDbParameterGroupCreate,
+		ReadContext:   resourceAwsMemoryDbParameterGroupRead,
+		UpdateContext: resourceAwsMemoryDbParameterGroupUpdate,
+		DeleteContext: resourceAwsMemoryDbParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateMemoryDbName(255),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateMemoryDbNamePrefix(255),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(v.(string))
+							},
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: memoryDbParameterHash,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsMemoryDbParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               tags.IgnoreAws().MemorydbTags(),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	_, err := conn.CreateParameterGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MemoryDB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Update to apply parameter changes.
+	return resourceAwsMemoryDbParameterGroupUpdate(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toRemove, toAdd := memoryDbParameterChanges(o, n)
+
+		log.Printf("[DEBUG] Parameters to remove: %#v", toRemove)
+		log.Printf("[DEBUG] Parameters to add or update: %#v", toAdd)
+
+		for len(toRemove) > 0 {
+			var paramsToReset []*memorydb.ParameterNameValue
+
+			if len(toRemove) <= memoryDbParameterGroupMaxParameters {
+				paramsToReset, toRemove = toRemove[:], nil
+			} else {
+				paramsToReset, toRemove = toRemove[:memoryDbParameterGroupMaxParameters], toRemove[memoryDbParameterGroupMaxParameters:]
+			}
+
+			input := &memorydb.ResetParameterGroupInput{
+				ParameterGroupName: aws.String(d.Id()),
+			}
+
+			for _, param := range paramsToReset {
+				input.ParameterNames = append(input.ParameterNames, param.ParameterName)
+			}
+
+			log.Printf("[DEBUG] Resetting MemoryDB Parameter Group (%s) parameters", d.Id())
+			_, err := conn.ResetParameterGroupWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+			}
+		}
+
+		for len(toAdd) > 0 {
+			var paramsToModify []*memorydb.ParameterNameValue
+
+			if len(toAdd) <= memoryDbParameterGroupMaxParameters {
+				paramsToModify, toAdd = toAdd[:], nil
+			} else {
+				paramsToModify, toAdd = toAdd[:memoryDbParameterGroupMaxParameters], toAdd[memoryDbParameterGroupMaxParameters:]
+			}
+
+			input := &memorydb.UpdateParameterGroupInput{
+				ParameterGroupName:  aws.String(d.Id()),
+				ParameterNameValues: paramsToModify,
+			}
+
+			log.Printf("[DEBUG] Modifying MemoryDB Parameter Group (%s) parameters", d.Id())
+			_, err := conn.UpdateParameterGroupWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MemorydbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MemoryDB Parameter Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMemoryDbParameterGroupRead(ctx, d, meta)
+}
+
+func resourceAwsMemoryDbParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	group, err := finder.ParameterGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(group.Name)))
+
+	userDefinedParameters := make(map[string]struct{})
+
+	for _, v := range d.Get("parameter").(*schema.Set).List() {
+		name := strings.ToLower(v.(map[string]interface{})["name"].(string))
+		userDefinedParameters[name] = struct{}{}
+	}
+
+	parameters, err := finder.ParametersByParameterGroupName(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing parameters for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	// Only the parameters in the configuration are tracked,
+	// as the API returns all parameters including defaults.
+	if err := d.Set("parameter", flattenMemoryDbParameters(parameters, userDefinedParameters)); err != nil {
+		return diag.Errorf("error setting parameter: %s", err)
+	}
+
+	tags, err := keyvaluetags.MemorydbListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMemoryDbParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).memorydbconn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: (%s)", d.Id())
+	_, err := conn.DeleteParameterGroupWithContext(ctx, &memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func memoryDbParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["name"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func memoryDbParameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.ParameterNameValue) {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	om := make(map[string]*memorydb.ParameterNameValue, os.Len())
+	for _, raw := range os.List() {
+		param := raw.(map[string]interface{})
+		om[strings.ToLower(param["name"].(string))] = expandMemoryDbParameter(param)
+	}
+	nm := make(map[string]*memorydb.ParameterNameValue, ns.Len())
+	for _, raw := range ns.List() {
+		param := raw.(map[string]interface{})
+		nm[strings.ToLower(param["name"].(string))] = expandMemoryDbParameter(param)
+	}
+
+	// Remove: key is in old, but not in new
+	remove = make([]*memorydb.ParameterNameValue, 0, os.Len())
+	for k := range om {
+		if _, ok := nm[k]; !ok {
+			remove = append(remove, om[k])
+		}
+	}
+
+	// Add or Update: key is in new, but not in old or has changed value
+	addOrUpdate = make([]*memorydb.ParameterNameValue, 0, ns.Len())
+	for k, nv := range nm {
+		ov, ok := om[k]
+		if !ok || aws.StringValue(ov.ParameterValue) != aws.StringValue(nv.ParameterValue) {
+			addOrUpdate = append(addOrUpdate, nm[k])
+		}
+	}
+
+	return remove, addOrUpdate
+}
+
+func flattenMemoryDbParameters(list []*memorydb.Parameter, userDefinedParameters map[string]struct{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+
+	for _, i := range list {
+		name := strings.ToLower(aws.StringValue(i.Name))
+
+		if _, ok := userDefinedParameters[name]; !ok {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":  name,
+			"value": aws.StringValue(i.Value),
+		})
+	}
+
+	return result
+}
+
+func expandMemoryDbParameter(param map[string]interface{}) *memorydb.ParameterNameValue {
+	return &memorydb.ParameterNameValue{
+		ParameterName:  aws.String(param["name"].(string)),
+		ParameterValue: aws.String(param["value"].(string)),
+	}
+}
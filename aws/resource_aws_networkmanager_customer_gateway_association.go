@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerCustomerGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsNetworkManagerCustomerGatewayAssociationCreate,
+		ReadContext:   resourceAwsNetworkManagerCustomerGatewayAssociationRead,
+		DeleteContext: resourceAwsNetworkManagerCustomerGatewayAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.CustomerGatewayAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.CustomerGatewayAssociationDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"customer_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	customerGatewayARN := d.Get("customer_gateway_arn").(string)
+	id := tfnetworkmanager.CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN)
+	input := &networkmanager.AssociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		DeviceId:           aws.String(d.Get("device_id").(string)),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("link_id"); ok {
+		input.LinkId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Customer Gateway Association: %s", input)
+	_, err := conn.AssociateCustomerGatewayWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Customer Gateway Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.CustomerGatewayAssociationCreated(ctx, conn, globalNetworkID, customerGatewayARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Customer Gateway Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerCustomerGatewayAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := finder.CustomerGatewayAssociationByTwoPartKey(ctx, conn, globalNetworkID, customerGatewayARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Customer Gateway Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Customer Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("customer_gateway_arn", association.CustomerGatewayArn)
+	d.Set("device_id", association.DeviceId)
+	d.Set("global_network_id", association.GlobalNetworkId)
+	d.Set("link_id", association.LinkId)
+
+	return nil
+}
+
+func resourceAwsNetworkManagerCustomerGatewayAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, customerGatewayARN, err := tfnetworkmanager.CustomerGatewayAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Customer Gateway Association: %s", d.Id())
+	_, err = conn.DisassociateCustomerGatewayWithContext(ctx, &networkmanager.DisassociateCustomerGatewayInput{
+		CustomerGatewayArn: aws.String(customerGatewayARN),
+		GlobalNetworkId:    aws.String(globalNetworkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Customer Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.CustomerGatewayAssociationDeleted(ctx, conn, globalNetworkID, customerGatewayARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Customer Gateway Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
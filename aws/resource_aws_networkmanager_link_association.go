@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsNetworkManagerLinkAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsNetworkManagerLinkAssociationCreate,
+		ReadContext:   resourceAwsNetworkManagerLinkAssociationRead,
+		DeleteContext: resourceAwsNetworkManagerLinkAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.LinkAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.LinkAssociationDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsNetworkManagerLinkAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	linkID := d.Get("link_id").(string)
+	deviceID := d.Get("device_id").(string)
+	id := tfnetworkmanager.LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID)
+	input := &networkmanager.AssociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link Association: %s", input)
+	_, err := conn.AssociateLinkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Link Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.LinkAssociationCreated(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerLinkAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsNetworkManagerLinkAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := finder.LinkAssociationByThreePartKey(ctx, conn, globalNetworkID, linkID, deviceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Link Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Link Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("device_id", association.DeviceId)
+	d.Set("global_network_id", association.GlobalNetworkId)
+	d.Set("link_id", association.LinkId)
+
+	return nil
+}
+
+func resourceAwsNetworkManagerLinkAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Link Association: %s", d.Id())
+	_, err = conn.DisassociateLinkWithContext(ctx, &networkmanager.DisassociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Link Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.LinkAssociationDeleted(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
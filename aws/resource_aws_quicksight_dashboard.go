@@ -0,0 +1,648 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsQuickSightDashboardCreate,
+		ReadContext:   resourceAwsQuickSightDashboardRead,
+		UpdateContext: resourceAwsQuickSightDashboardUpdate,
+		DeleteContext: resourceAwsQuickSightDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DashboardVersionTimeout),
+			Update: schema.DefaultTimeout(waiter.DashboardVersionTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"dashboard_publish_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_hoc_filtering_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardBehavior_Values(), false),
+									},
+								},
+							},
+						},
+						"export_to_csv_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardBehavior_Values(), false),
+									},
+								},
+							},
+						},
+						"sheet_controls_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"visibility_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardUIState_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"last_published_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date_time_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+								},
+							},
+						},
+						"decimal_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeFloat},
+									},
+								},
+							},
+						},
+						"integer_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+						"string_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"permission": quickSightPermissionsSchema(),
+			"source_entity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_template": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"data_set_references": quickSightDataSetReferencesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	dashboardID := d.Get("dashboard_id").(string)
+	id := tfquicksight.DashboardCreateResourceID(awsAccountID, dashboardID)
+
+	input := &quicksight.CreateDashboardInput{
+		AwsAccountId:       aws.String(awsAccountID),
+		DashboardId:        aws.String(dashboardID),
+		Name:               aws.String(d.Get("name").(string)),
+		SourceEntity:       expandQuickSightDashboardSourceEntity(d.Get("source_entity").([]interface{})),
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("dashboard_publish_options"); ok {
+		input.DashboardPublishOptions = expandQuickSightDashboardPublishOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandQuickSightDashboardParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightPermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		input.ThemeArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().QuicksightTags()
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Dashboard: %s", input)
+	_, err := conn.CreateDashboardWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Dashboard (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	dashboard, err := waiter.DashboardVersionCreated(ctx, conn, awsAccountID, dashboardID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf("error waiting for QuickSight Dashboard (%s) to be created: %s", d.Id(), err)
+	}
+
+	if err := quickSightDashboardPublishVersion(ctx, conn, awsAccountID, dashboardID, dashboard.Version.VersionNumber); err != nil {
+		return diag.Errorf("error publishing QuickSight Dashboard (%s) version: %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightDashboardRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, dashboardID, err := tfquicksight.DashboardParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := finder.DashboardByID(ctx, conn, awsAccountID, dashboardID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dashboard.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("created_time", aws.TimeValue(dashboard.CreatedTime).Format(time.RFC3339))
+	d.Set("dashboard_id", dashboard.DashboardId)
+	d.Set("last_published_time", aws.TimeValue(dashboard.LastPublishedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(dashboard.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", dashboard.Name)
+
+	if version := dashboard.Version; version != nil {
+		d.Set("source_entity_arn", version.SourceEntityArn)
+		d.Set("status", version.Status)
+		d.Set("theme_arn", version.ThemeArn)
+		d.Set("version_description", version.Description)
+		d.Set("version_number", version.VersionNumber)
+	}
+
+	permissionsOutput, err := conn.DescribeDashboardPermissionsWithContext(ctx, &quicksight.DescribeDashboardPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Dashboard (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permissionsOutput.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, aws.StringValue(dashboard.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, err := tfquicksight.DashboardParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDashboardInput{
+			AwsAccountId:       aws.String(awsAccountID),
+			DashboardId:        aws.String(dashboardID),
+			Name:               aws.String(d.Get("name").(string)),
+			SourceEntity:       expandQuickSightDashboardSourceEntity(d.Get("source_entity").([]interface{})),
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		if v, ok := d.GetOk("dashboard_publish_options"); ok {
+			input.DashboardPublishOptions = expandQuickSightDashboardPublishOptions(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("parameters"); ok {
+			input.Parameters = expandQuickSightDashboardParameters(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("theme_arn"); ok {
+			input.ThemeArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Dashboard (%s): %s", d.Id(), input)
+		_, err := conn.UpdateDashboardWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s): %s", d.Id(), err)
+		}
+
+		dashboard, err := waiter.DashboardVersionCreated(ctx, conn, awsAccountID, dashboardID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("error waiting for QuickSight Dashboard (%s) to be updated: %s", d.Id(), err)
+		}
+
+		if err := quickSightDashboardPublishVersion(ctx, conn, awsAccountID, dashboardID, dashboard.Version.VersionNumber); err != nil {
+			return diag.Errorf("error publishing QuickSight Dashboard (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		grants, revokes := diffQuickSightPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+		input := &quicksight.UpdateDashboardPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DashboardId:  aws.String(dashboardID),
+		}
+
+		if len(grants) > 0 {
+			input.GrantPermissions = grants
+		}
+
+		if len(revokes) > 0 {
+			input.RevokePermissions = revokes
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Dashboard (%s) permissions: %s", d.Id(), input)
+		_, err := conn.UpdateDashboardPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Dashboard (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightDashboardRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dashboardID, err := tfquicksight.DashboardParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Dashboard: (%s)", d.Id())
+	_, err = conn.DeleteDashboardWithContext(ctx, &quicksight.DeleteDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// quickSightDashboardPublishVersion makes the specified dashboard version the one that readers see.
+func quickSightDashboardPublishVersion(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber *int64) error {
+	input := &quicksight.UpdateDashboardPublishedVersionInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		DashboardId:   aws.String(dashboardID),
+		VersionNumber: versionNumber,
+	}
+
+	log.Printf("[DEBUG] Updating QuickSight Dashboard published version: %s", input)
+	_, err := conn.UpdateDashboardPublishedVersionWithContext(ctx, input)
+
+	return err
+}
+
+func expandQuickSightDashboardSourceEntity(tfList []interface{}) *quicksight.DashboardSourceEntity {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.DashboardSourceEntity{}
+
+	if tfMap := quickSightSingleBlock(tfMap["source_template"]); tfMap != nil {
+		apiObject.SourceTemplate = &quicksight.DashboardSourceTemplate{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandQuickSightDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDashboardPublishOptions(tfList []interface{}) *quicksight.DashboardPublishOptions {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.DashboardPublishOptions{}
+
+	if tfMap := quickSightSingleBlock(tfMap["ad_hoc_filtering_option"]); tfMap != nil {
+		apiObject.AdHocFilteringOption = &quicksight.AdHocFilteringOption{}
+
+		if v, ok := tfMap["availability_status"].(string); ok && v != "" {
+			apiObject.AdHocFilteringOption.AvailabilityStatus = aws.String(v)
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["export_to_csv_option"]); tfMap != nil {
+		apiObject.ExportToCSVOption = &quicksight.ExportToCSVOption{}
+
+		if v, ok := tfMap["availability_status"].(string); ok && v != "" {
+			apiObject.ExportToCSVOption.AvailabilityStatus = aws.String(v)
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["sheet_controls_option"]); tfMap != nil {
+		apiObject.SheetControlsOption = &quicksight.SheetControlsOption{}
+
+		if v, ok := tfMap["visibility_state"].(string); ok && v != "" {
+			apiObject.SheetControlsOption.VisibilityState = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDashboardParameters(tfList []interface{}) *quicksight.Parameters {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.Parameters{}
+
+	for _, tfMapRaw := range tfMap["date_time_parameters"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var values []*time.Time
+
+		for _, v := range tfMap["values"].([]interface{}) {
+			t, _ := time.Parse(time.RFC3339, v.(string))
+			values = append(values, aws.Time(t))
+		}
+
+		apiObject.DateTimeParameters = append(apiObject.DateTimeParameters, &quicksight.DateTimeParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: values,
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["decimal_parameters"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var values []*float64
+
+		for _, v := range tfMap["values"].([]interface{}) {
+			values = append(values, aws.Float64(v.(float64)))
+		}
+
+		apiObject.DecimalParameters = append(apiObject.DecimalParameters, &quicksight.DecimalParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: values,
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["integer_parameters"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.IntegerParameters = append(apiObject.IntegerParameters, &quicksight.IntegerParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: expandInt64List(tfMap["values"].([]interface{})),
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["string_parameters"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.StringParameters = append(apiObject.StringParameters, &quicksight.StringParameter{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: expandStringList(tfMap["values"].([]interface{})),
+		})
+	}
+
+	return apiObject
+}
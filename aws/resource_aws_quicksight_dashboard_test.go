@@ -0,0 +1,373 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
+		Name: "aws_quicksight_dashboard",
+		F:    testSweepQuickSightDashboards,
+	})
+}
+
+func testSweepQuickSightDashboards(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).quicksightconn
+	awsAccountID := client.(*AWSClient).accountid
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &quicksight.ListDashboardsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	err = conn.ListDashboardsPages(input, func(page *quicksight.ListDashboardsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dashboard := range page.DashboardSummaryList {
+			if dashboard == nil {
+				continue
+			}
+
+			id := tfquicksight.DashboardCreateResourceID(awsAccountID, aws.StringValue(dashboard.DashboardId))
+
+			log.Printf("[INFO] Deleting QuickSight Dashboard (%s)", id)
+			r := resourceAwsQuickSightDashboard()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Dashboards: %w", err))
+	}
+
+	if err = testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Dashboards for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Dashboards sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSQuickSightDashboard_basic(t *testing.T) {
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDashboardConfig(rName, "Version 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dashboard/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Version 1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dashboard_publish_options", "parameters", "source_entity"},
+			},
+			{
+				Config: testAccAWSQuickSightDashboardConfig(rName, "Version 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Version 2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDashboard_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDashboardConfig(rName, "Version 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightDashboard(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDashboard_publishOptions(t *testing.T) {
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDashboardConfigPublishOptions(rName, quicksight.DashboardBehaviorDisabled, quicksight.DashboardUIStateCollapsed),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.ad_hoc_filtering_option.0.availability_status", quicksight.DashboardBehaviorDisabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_to_csv_option.0.availability_status", quicksight.DashboardBehaviorDisabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.sheet_controls_option.0.visibility_state", quicksight.DashboardUIStateCollapsed),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDashboardConfigPublishOptions(rName, quicksight.DashboardBehaviorEnabled, quicksight.DashboardUIStateExpanded),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.ad_hoc_filtering_option.0.availability_status", quicksight.DashboardBehaviorEnabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_to_csv_option.0.availability_status", quicksight.DashboardBehaviorEnabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.sheet_controls_option.0.visibility_state", quicksight.DashboardUIStateExpanded),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDashboard_tags(t *testing.T) {
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDashboardConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dashboard_publish_options", "parameters", "source_entity"},
+			},
+			{
+				Config: testAccAWSQuickSightDashboardConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDashboardConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDashboardExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightDashboardDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_dashboard" {
+			continue
+		}
+
+		awsAccountID, dashboardID, err := tfquicksight.DashboardParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.DashboardByID(context.Background(), conn, awsAccountID, dashboardID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Dashboard %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightDashboardExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Dashboard ID is set")
+		}
+
+		awsAccountID, dashboardID, err := tfquicksight.DashboardParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.DashboardByID(context.Background(), conn, awsAccountID, dashboardID)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightDashboardConfigSourceTemplate() string {
+	return fmt.Sprintf(`
+  source_entity {
+    source_template {
+      arn = aws_quicksight_template.test.arn
+
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = %[1]q
+      }
+    }
+  }
+`, os.Getenv("AWS_QUICKSIGHT_ANALYSIS_DATA_SET_PLACEHOLDER"))
+}
+
+func testAccAWSQuickSightDashboardConfig(rName, versionDescription string) string {
+	return composeConfig(
+		testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[1]q
+  version_description = %[2]q
+
+%[3]s
+}
+`, rName, versionDescription, testAccAWSQuickSightDashboardConfigSourceTemplate()))
+}
+
+func testAccAWSQuickSightDashboardConfigPublishOptions(rName, behavior, uiState string) string {
+	return composeConfig(
+		testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[1]q
+  version_description = "Version 1"
+
+%[2]s
+
+  dashboard_publish_options {
+    ad_hoc_filtering_option {
+      availability_status = %[3]q
+    }
+
+    export_to_csv_option {
+      availability_status = %[3]q
+    }
+
+    sheet_controls_option {
+      visibility_state = %[4]q
+    }
+  }
+}
+`, rName, testAccAWSQuickSightDashboardConfigSourceTemplate(), behavior, uiState))
+}
+
+func testAccAWSQuickSightDashboardConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[1]q
+  version_description = "Version 1"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAWSQuickSightDashboardConfigSourceTemplate(), tagKey1, tagValue1))
+}
+
+func testAccAWSQuickSightDashboardConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[1]q
+  version_description = "Version 1"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAWSQuickSightDashboardConfigSourceTemplate(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
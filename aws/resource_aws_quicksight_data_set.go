@@ -0,0 +1,1622 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightDataSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsQuickSightDataSetCreate,
+		ReadContext:   resourceAwsQuickSightDataSetRead,
+		UpdateContext: resourceAwsQuickSightDataSetUpdate,
+		DeleteContext: resourceAwsQuickSightDataSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"column_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"geo_spatial_column_group": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 16,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"country_code": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.GeoSpatialCountryCode_Values(), false),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"column_level_permission_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principals": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"data_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"field_folders": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5000,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 500),
+						},
+						"field_folders_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"import_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSetImportMode_Values(), false),
+			},
+			"logical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"data_transforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2048,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cast_column_type_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 32),
+												},
+												"new_column_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.ColumnDataType_Values(), false),
+												},
+											},
+										},
+									},
+									"create_columns_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 128,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 64),
+															},
+															"column_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"expression": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 4096),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"filter_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"project_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"projected_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 2000,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"rename_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"new_column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"tag_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"tags": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 16,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_description": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"text": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(0, 500),
+																		},
+																	},
+																},
+															},
+															"column_geographic_role": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(quicksight.GeoSpatialDataRole_Values(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"logical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"join_instruction": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left_join_key_properties":  quickSightJoinKeyPropertiesSchema(),
+												"left_operand":              {Type: schema.TypeString, Required: true, ValidateFunc: validation.StringLenBetween(1, 64)},
+												"on_clause":                 {Type: schema.TypeString, Required: true, ValidateFunc: validation.StringLenBetween(1, 512)},
+												"right_join_key_properties": quickSightJoinKeyPropertiesSchema(),
+												"right_operand":             {Type: schema.TypeString, Required: true, ValidateFunc: validation.StringLenBetween(1, 64)},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.JoinType_Values(), false),
+												},
+											},
+										},
+									},
+									"physical_table_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"output_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"permission": quickSightPermissionsSchema(),
+			"physical_table_map": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_sql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": quickSightInputColumnsSchema(),
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"sql_query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65536),
+									},
+								},
+							},
+						},
+						"physical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"relational_table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"input_columns": quickSightInputColumnsSchema(),
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"s3_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"input_columns": quickSightInputColumnsSchema(),
+									"upload_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contains_header": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.FileFormat_Values(), false),
+												},
+												"start_from_row": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"text_qualifier": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.TextQualifier_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"row_level_permission_data_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"format_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionFormatVersion_Values(), false),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 64),
+						},
+						"permission_policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.RowLevelPermissionPolicy_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+					},
+				},
+			},
+			"row_level_permission_tag_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.Status_Values(), false),
+						},
+						"tag_rules": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"match_all_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"tag_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"tag_multi_value_delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func quickSightInputColumnsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 2048,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.InputColumnDataType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func quickSightJoinKeyPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unique_key": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	dataSetID := d.Get("data_set_id").(string)
+	id := tfquicksight.DataSetCreateResourceID(awsAccountID, dataSetID)
+
+	input := &quicksight.CreateDataSetInput{
+		AwsAccountId:     aws.String(awsAccountID),
+		DataSetId:        aws.String(dataSetID),
+		ImportMode:       aws.String(d.Get("import_mode").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		PhysicalTableMap: expandQuickSightDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("column_groups"); ok && len(v.([]interface{})) > 0 {
+		input.ColumnGroups = expandQuickSightDataSetColumnGroups(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("column_level_permission_rules"); ok && len(v.([]interface{})) > 0 {
+		input.ColumnLevelPermissionRules = expandQuickSightDataSetColumnLevelPermissionRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("field_folders"); ok && v.(*schema.Set).Len() > 0 {
+		input.FieldFolders = expandQuickSightDataSetFieldFolders(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogicalTableMap = expandQuickSightDataSetLogicalTableMap(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightPermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("row_level_permission_data_set"); ok {
+		input.RowLevelPermissionDataSet = expandQuickSightDataSetRowLevelPermissionDataSet(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("row_level_permission_tag_configuration"); ok {
+		input.RowLevelPermissionTagConfiguration = expandQuickSightDataSetRowLevelPermissionTagConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().QuicksightTags()
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Data Set: %s", input)
+	_, err := conn.CreateDataSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Data Set (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsQuickSightDataSetRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDataSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, dataSetID, err := tfquicksight.DataSetParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSet, err := finder.DataSetByID(ctx, conn, awsAccountID, dataSetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataSet.Arn)
+	d.Set("aws_account_id", awsAccountID)
+
+	if err := d.Set("column_groups", flattenQuickSightDataSetColumnGroups(dataSet.ColumnGroups)); err != nil {
+		return diag.Errorf("error setting column_groups: %s", err)
+	}
+
+	if err := d.Set("column_level_permission_rules", flattenQuickSightDataSetColumnLevelPermissionRules(dataSet.ColumnLevelPermissionRules)); err != nil {
+		return diag.Errorf("error setting column_level_permission_rules: %s", err)
+	}
+
+	d.Set("data_set_id", dataSet.DataSetId)
+
+	if err := d.Set("field_folders", flattenQuickSightDataSetFieldFolders(dataSet.FieldFolders)); err != nil {
+		return diag.Errorf("error setting field_folders: %s", err)
+	}
+
+	d.Set("import_mode", dataSet.ImportMode)
+
+	if err := d.Set("logical_table_map", flattenQuickSightDataSetLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
+		return diag.Errorf("error setting logical_table_map: %s", err)
+	}
+
+	d.Set("name", dataSet.Name)
+
+	if err := d.Set("output_columns", flattenQuickSightDataSetOutputColumns(dataSet.OutputColumns)); err != nil {
+		return diag.Errorf("error setting output_columns: %s", err)
+	}
+
+	if err := d.Set("physical_table_map", flattenQuickSightDataSetPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
+		return diag.Errorf("error setting physical_table_map: %s", err)
+	}
+
+	if err := d.Set("row_level_permission_data_set", flattenQuickSightDataSetRowLevelPermissionDataSet(dataSet.RowLevelPermissionDataSet)); err != nil {
+		return diag.Errorf("error setting row_level_permission_data_set: %s", err)
+	}
+
+	if err := d.Set("row_level_permission_tag_configuration", flattenQuickSightDataSetRowLevelPermissionTagConfiguration(dataSet.RowLevelPermissionTagConfiguration)); err != nil {
+		return diag.Errorf("error setting row_level_permission_tag_configuration: %s", err)
+	}
+
+	permissionsOutput, err := conn.DescribeDataSetPermissionsWithContext(ctx, &quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Set (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permissionsOutput.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, aws.StringValue(dataSet.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, err := tfquicksight.DataSetParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDataSetInput{
+			AwsAccountId:     aws.String(awsAccountID),
+			DataSetId:        aws.String(dataSetID),
+			ImportMode:       aws.String(d.Get("import_mode").(string)),
+			Name:             aws.String(d.Get("name").(string)),
+			PhysicalTableMap: expandQuickSightDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("column_groups"); ok && len(v.([]interface{})) > 0 {
+			input.ColumnGroups = expandQuickSightDataSetColumnGroups(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("column_level_permission_rules"); ok && len(v.([]interface{})) > 0 {
+			input.ColumnLevelPermissionRules = expandQuickSightDataSetColumnLevelPermissionRules(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("field_folders"); ok && v.(*schema.Set).Len() > 0 {
+			input.FieldFolders = expandQuickSightDataSetFieldFolders(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+			input.LogicalTableMap = expandQuickSightDataSetLogicalTableMap(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("row_level_permission_data_set"); ok {
+			input.RowLevelPermissionDataSet = expandQuickSightDataSetRowLevelPermissionDataSet(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("row_level_permission_tag_configuration"); ok {
+			input.RowLevelPermissionTagConfiguration = expandQuickSightDataSetRowLevelPermissionTagConfiguration(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Set (%s): %s", d.Id(), input)
+		_, err := conn.UpdateDataSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		grants, revokes := diffQuickSightPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+		input := &quicksight.UpdateDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSetId:    aws.String(dataSetID),
+		}
+
+		if len(grants) > 0 {
+			input.GrantPermissions = grants
+		}
+
+		if len(revokes) > 0 {
+			input.RevokePermissions = revokes
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Set (%s) permissions: %s", d.Id(), input)
+		_, err := conn.UpdateDataSetPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Data Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightDataSetRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDataSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSetID, err := tfquicksight.DataSetParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Data Set: (%s)", d.Id())
+	_, err = conn.DeleteDataSetWithContext(ctx, &quicksight.DeleteDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightDataSetColumnGroups(tfList []interface{}) []*quicksight.ColumnGroup {
+	var apiObjects []*quicksight.ColumnGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.ColumnGroup{}
+
+		if tfMap := quickSightSingleBlock(tfMap["geo_spatial_column_group"]); tfMap != nil {
+			apiObject.GeoSpatialColumnGroup = &quicksight.GeoSpatialColumnGroup{
+				Columns:     expandStringList(tfMap["columns"].([]interface{})),
+				CountryCode: aws.String(tfMap["country_code"].(string)),
+				Name:        aws.String(tfMap["name"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetColumnGroups(apiObjects []*quicksight.ColumnGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.GeoSpatialColumnGroup; v != nil {
+			tfMap["geo_spatial_column_group"] = []interface{}{map[string]interface{}{
+				"columns":      aws.StringValueSlice(v.Columns),
+				"country_code": aws.StringValue(v.CountryCode),
+				"name":         aws.StringValue(v.Name),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetColumnLevelPermissionRules(tfList []interface{}) []*quicksight.ColumnLevelPermissionRule {
+	var apiObjects []*quicksight.ColumnLevelPermissionRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.ColumnLevelPermissionRule{}
+
+		if v, ok := tfMap["column_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ColumnNames = expandStringList(v)
+		}
+
+		if v, ok := tfMap["principals"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Principals = expandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetColumnLevelPermissionRules(apiObjects []*quicksight.ColumnLevelPermissionRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"column_names": aws.StringValueSlice(apiObject.ColumnNames),
+			"principals":   aws.StringValueSlice(apiObject.Principals),
+		})
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetFieldFolders(tfList []interface{}) map[string]*quicksight.FieldFolder {
+	apiObjects := make(map[string]*quicksight.FieldFolder)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.FieldFolder{}
+
+		if v, ok := tfMap["columns"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Columns = expandStringList(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects[tfMap["field_folders_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetFieldFolders(apiObjects map[string]*quicksight.FieldFolder) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"columns":          aws.StringValueSlice(apiObject.Columns),
+			"description":      aws.StringValue(apiObject.Description),
+			"field_folders_id": k,
+		})
+	}
+
+	return tfList
+}
+
+func expandQuickSightInputColumns(tfList []interface{}) []*quicksight.InputColumn {
+	var apiObjects []*quicksight.InputColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.InputColumn{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightInputColumns(apiObjects []*quicksight.InputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetPhysicalTableMap(tfList []interface{}) map[string]*quicksight.PhysicalTable {
+	apiObjects := make(map[string]*quicksight.PhysicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.PhysicalTable{}
+
+		if tfMap := quickSightSingleBlock(tfMap["custom_sql"]); tfMap != nil {
+			apiObject.CustomSql = &quicksight.CustomSql{
+				Columns:       expandQuickSightInputColumns(tfMap["columns"].([]interface{})),
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				Name:          aws.String(tfMap["name"].(string)),
+				SqlQuery:      aws.String(tfMap["sql_query"].(string)),
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["relational_table"]); tfMap != nil {
+			apiObject.RelationalTable = &quicksight.RelationalTable{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandQuickSightInputColumns(tfMap["input_columns"].([]interface{})),
+				Name:          aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["catalog"].(string); ok && v != "" {
+				apiObject.RelationalTable.Catalog = aws.String(v)
+			}
+
+			if v, ok := tfMap["schema"].(string); ok && v != "" {
+				apiObject.RelationalTable.Schema = aws.String(v)
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["s3_source"]); tfMap != nil {
+			apiObject.S3Source = &quicksight.S3Source{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandQuickSightInputColumns(tfMap["input_columns"].([]interface{})),
+			}
+
+			if tfMap := quickSightSingleBlock(tfMap["upload_settings"]); tfMap != nil {
+				uploadSettings := &quicksight.UploadSettings{}
+
+				if v, ok := tfMap["contains_header"].(bool); ok {
+					uploadSettings.ContainsHeader = aws.Bool(v)
+				}
+
+				if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+					uploadSettings.Delimiter = aws.String(v)
+				}
+
+				if v, ok := tfMap["format"].(string); ok && v != "" {
+					uploadSettings.Format = aws.String(v)
+				}
+
+				if v, ok := tfMap["start_from_row"].(int); ok && v != 0 {
+					uploadSettings.StartFromRow = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["text_qualifier"].(string); ok && v != "" {
+					uploadSettings.TextQualifier = aws.String(v)
+				}
+
+				apiObject.S3Source.UploadSettings = uploadSettings
+			}
+		}
+
+		apiObjects[tfMap["physical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetPhysicalTableMap(apiObjects map[string]*quicksight.PhysicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"physical_table_map_id": k,
+		}
+
+		if v := apiObject.CustomSql; v != nil {
+			tfMap["custom_sql"] = []interface{}{map[string]interface{}{
+				"columns":         flattenQuickSightInputColumns(v.Columns),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"name":            aws.StringValue(v.Name),
+				"sql_query":       aws.StringValue(v.SqlQuery),
+			}}
+		}
+
+		if v := apiObject.RelationalTable; v != nil {
+			tfMap["relational_table"] = []interface{}{map[string]interface{}{
+				"catalog":         aws.StringValue(v.Catalog),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenQuickSightInputColumns(v.InputColumns),
+				"name":            aws.StringValue(v.Name),
+				"schema":          aws.StringValue(v.Schema),
+			}}
+		}
+
+		if v := apiObject.S3Source; v != nil {
+			s3Source := map[string]interface{}{
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenQuickSightInputColumns(v.InputColumns),
+			}
+
+			if v := v.UploadSettings; v != nil {
+				s3Source["upload_settings"] = []interface{}{map[string]interface{}{
+					"contains_header": aws.BoolValue(v.ContainsHeader),
+					"delimiter":       aws.StringValue(v.Delimiter),
+					"format":          aws.StringValue(v.Format),
+					"start_from_row":  aws.Int64Value(v.StartFromRow),
+					"text_qualifier":  aws.StringValue(v.TextQualifier),
+				}}
+			}
+
+			tfMap["s3_source"] = []interface{}{s3Source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetLogicalTableMap(tfList []interface{}) map[string]*quicksight.LogicalTable {
+	apiObjects := make(map[string]*quicksight.LogicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.LogicalTable{
+			Alias: aws.String(tfMap["alias"].(string)),
+		}
+
+		if v, ok := tfMap["data_transforms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DataTransforms = expandQuickSightDataSetTransformOperations(v)
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["source"]); tfMap != nil {
+			source := &quicksight.LogicalTableSource{}
+
+			if v, ok := tfMap["physical_table_id"].(string); ok && v != "" {
+				source.PhysicalTableId = aws.String(v)
+			}
+
+			if tfMap := quickSightSingleBlock(tfMap["join_instruction"]); tfMap != nil {
+				source.JoinInstruction = &quicksight.JoinInstruction{
+					LeftOperand:  aws.String(tfMap["left_operand"].(string)),
+					OnClause:     aws.String(tfMap["on_clause"].(string)),
+					RightOperand: aws.String(tfMap["right_operand"].(string)),
+					Type:         aws.String(tfMap["type"].(string)),
+				}
+
+				if tfMap := quickSightSingleBlock(tfMap["left_join_key_properties"]); tfMap != nil {
+					source.JoinInstruction.LeftJoinKeyProperties = &quicksight.JoinKeyProperties{
+						UniqueKey: aws.Bool(tfMap["unique_key"].(bool)),
+					}
+				}
+
+				if tfMap := quickSightSingleBlock(tfMap["right_join_key_properties"]); tfMap != nil {
+					source.JoinInstruction.RightJoinKeyProperties = &quicksight.JoinKeyProperties{
+						UniqueKey: aws.Bool(tfMap["unique_key"].(bool)),
+					}
+				}
+			}
+
+			apiObject.Source = source
+		}
+
+		apiObjects[tfMap["logical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetLogicalTableMap(apiObjects map[string]*quicksight.LogicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"alias":                aws.StringValue(apiObject.Alias),
+			"data_transforms":      flattenQuickSightDataSetTransformOperations(apiObject.DataTransforms),
+			"logical_table_map_id": k,
+		}
+
+		if v := apiObject.Source; v != nil {
+			source := map[string]interface{}{
+				"physical_table_id": aws.StringValue(v.PhysicalTableId),
+			}
+
+			if v := v.JoinInstruction; v != nil {
+				joinInstruction := map[string]interface{}{
+					"left_operand":  aws.StringValue(v.LeftOperand),
+					"on_clause":     aws.StringValue(v.OnClause),
+					"right_operand": aws.StringValue(v.RightOperand),
+					"type":          aws.StringValue(v.Type),
+				}
+
+				if v := v.LeftJoinKeyProperties; v != nil {
+					joinInstruction["left_join_key_properties"] = []interface{}{map[string]interface{}{
+						"unique_key": aws.BoolValue(v.UniqueKey),
+					}}
+				}
+
+				if v := v.RightJoinKeyProperties; v != nil {
+					joinInstruction["right_join_key_properties"] = []interface{}{map[string]interface{}{
+						"unique_key": aws.BoolValue(v.UniqueKey),
+					}}
+				}
+
+				source["join_instruction"] = []interface{}{joinInstruction}
+			}
+
+			tfMap["source"] = []interface{}{source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetTransformOperations(tfList []interface{}) []*quicksight.TransformOperation {
+	var apiObjects []*quicksight.TransformOperation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TransformOperation{}
+
+		if tfMap := quickSightSingleBlock(tfMap["cast_column_type_operation"]); tfMap != nil {
+			apiObject.CastColumnTypeOperation = &quicksight.CastColumnTypeOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnType: aws.String(tfMap["new_column_type"].(string)),
+			}
+
+			if v, ok := tfMap["format"].(string); ok && v != "" {
+				apiObject.CastColumnTypeOperation.Format = aws.String(v)
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["create_columns_operation"]); tfMap != nil {
+			var columns []*quicksight.CalculatedColumn
+
+			for _, tfMapRaw := range tfMap["columns"].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				columns = append(columns, &quicksight.CalculatedColumn{
+					ColumnId:   aws.String(tfMap["column_id"].(string)),
+					ColumnName: aws.String(tfMap["column_name"].(string)),
+					Expression: aws.String(tfMap["expression"].(string)),
+				})
+			}
+
+			apiObject.CreateColumnsOperation = &quicksight.CreateColumnsOperation{
+				Columns: columns,
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["filter_operation"]); tfMap != nil {
+			apiObject.FilterOperation = &quicksight.FilterOperation{
+				ConditionExpression: aws.String(tfMap["condition_expression"].(string)),
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["project_operation"]); tfMap != nil {
+			apiObject.ProjectOperation = &quicksight.ProjectOperation{
+				ProjectedColumns: expandStringList(tfMap["projected_columns"].([]interface{})),
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["rename_column_operation"]); tfMap != nil {
+			apiObject.RenameColumnOperation = &quicksight.RenameColumnOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnName: aws.String(tfMap["new_column_name"].(string)),
+			}
+		}
+
+		if tfMap := quickSightSingleBlock(tfMap["tag_column_operation"]); tfMap != nil {
+			var tags []*quicksight.ColumnTag
+
+			for _, tfMapRaw := range tfMap["tags"].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				tag := &quicksight.ColumnTag{}
+
+				if tfMap := quickSightSingleBlock(tfMap["column_description"]); tfMap != nil {
+					tag.ColumnDescription = &quicksight.ColumnDescription{}
+
+					if v, ok := tfMap["text"].(string); ok && v != "" {
+						tag.ColumnDescription.Text = aws.String(v)
+					}
+				}
+
+				if v, ok := tfMap["column_geographic_role"].(string); ok && v != "" {
+					tag.ColumnGeographicRole = aws.String(v)
+				}
+
+				tags = append(tags, tag)
+			}
+
+			apiObject.TagColumnOperation = &quicksight.TagColumnOperation{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+				Tags:       tags,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightDataSetTransformOperations(apiObjects []*quicksight.TransformOperation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.CastColumnTypeOperation; v != nil {
+			tfMap["cast_column_type_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"format":          aws.StringValue(v.Format),
+				"new_column_type": aws.StringValue(v.NewColumnType),
+			}}
+		}
+
+		if v := apiObject.CreateColumnsOperation; v != nil {
+			var columns []interface{}
+
+			for _, v := range v.Columns {
+				if v == nil {
+					continue
+				}
+
+				columns = append(columns, map[string]interface{}{
+					"column_id":   aws.StringValue(v.ColumnId),
+					"column_name": aws.StringValue(v.ColumnName),
+					"expression":  aws.StringValue(v.Expression),
+				})
+			}
+
+			tfMap["create_columns_operation"] = []interface{}{map[string]interface{}{
+				"columns": columns,
+			}}
+		}
+
+		if v := apiObject.FilterOperation; v != nil {
+			tfMap["filter_operation"] = []interface{}{map[string]interface{}{
+				"condition_expression": aws.StringValue(v.ConditionExpression),
+			}}
+		}
+
+		if v := apiObject.ProjectOperation; v != nil {
+			tfMap["project_operation"] = []interface{}{map[string]interface{}{
+				"projected_columns": aws.StringValueSlice(v.ProjectedColumns),
+			}}
+		}
+
+		if v := apiObject.RenameColumnOperation; v != nil {
+			tfMap["rename_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"new_column_name": aws.StringValue(v.NewColumnName),
+			}}
+		}
+
+		if v := apiObject.TagColumnOperation; v != nil {
+			var tags []interface{}
+
+			for _, v := range v.Tags {
+				if v == nil {
+					continue
+				}
+
+				tag := map[string]interface{}{
+					"column_geographic_role": aws.StringValue(v.ColumnGeographicRole),
+				}
+
+				if v := v.ColumnDescription; v != nil {
+					tag["column_description"] = []interface{}{map[string]interface{}{
+						"text": aws.StringValue(v.Text),
+					}}
+				}
+
+				tags = append(tags, tag)
+			}
+
+			tfMap["tag_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name": aws.StringValue(v.ColumnName),
+				"tags":        tags,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQuickSightDataSetOutputColumns(apiObjects []*quicksight.OutputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"type":        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func expandQuickSightDataSetRowLevelPermissionDataSet(tfList []interface{}) *quicksight.RowLevelPermissionDataSet {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.RowLevelPermissionDataSet{
+		Arn:              aws.String(tfMap["arn"].(string)),
+		PermissionPolicy: aws.String(tfMap["permission_policy"].(string)),
+	}
+
+	if v, ok := tfMap["format_version"].(string); ok && v != "" {
+		apiObject.FormatVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenQuickSightDataSetRowLevelPermissionDataSet(apiObject *quicksight.RowLevelPermissionDataSet) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"arn":               aws.StringValue(apiObject.Arn),
+		"format_version":    aws.StringValue(apiObject.FormatVersion),
+		"namespace":         aws.StringValue(apiObject.Namespace),
+		"permission_policy": aws.StringValue(apiObject.PermissionPolicy),
+		"status":            aws.StringValue(apiObject.Status),
+	}}
+}
+
+func expandQuickSightDataSetRowLevelPermissionTagConfiguration(tfList []interface{}) *quicksight.RowLevelPermissionTagConfiguration {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.RowLevelPermissionTagConfiguration{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	for _, tfMapRaw := range tfMap["tag_rules"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tagRule := &quicksight.RowLevelPermissionTagRule{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+			TagKey:     aws.String(tfMap["tag_key"].(string)),
+		}
+
+		if v, ok := tfMap["match_all_value"].(string); ok && v != "" {
+			tagRule.MatchAllValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["tag_multi_value_delimiter"].(string); ok && v != "" {
+			tagRule.TagMultiValueDelimiter = aws.String(v)
+		}
+
+		apiObject.TagRules = append(apiObject.TagRules, tagRule)
+	}
+
+	return apiObject
+}
+
+func flattenQuickSightDataSetRowLevelPermissionTagConfiguration(apiObject *quicksight.RowLevelPermissionTagConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tagRules []interface{}
+
+	for _, v := range apiObject.TagRules {
+		if v == nil {
+			continue
+		}
+
+		tagRules = append(tagRules, map[string]interface{}{
+			"column_name":               aws.StringValue(v.ColumnName),
+			"match_all_value":           aws.StringValue(v.MatchAllValue),
+			"tag_key":                   aws.StringValue(v.TagKey),
+			"tag_multi_value_delimiter": aws.StringValue(v.TagMultiValueDelimiter),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"status":    aws.StringValue(apiObject.Status),
+		"tag_rules": tagRules,
+	}}
+}
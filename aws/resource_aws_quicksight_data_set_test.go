@@ -0,0 +1,392 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+		Name: "aws_quicksight_data_set",
+		F:    testSweepQuickSightDataSets,
+		Dependencies: []string{
+			"aws_quicksight_template",
+		},
+	})
+}
+
+func testSweepQuickSightDataSets(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).quicksightconn
+	awsAccountID := client.(*AWSClient).accountid
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &quicksight.ListDataSetsInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	err = conn.ListDataSetsPages(input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dataSet := range page.DataSetSummaries {
+			if dataSet == nil {
+				continue
+			}
+
+			id := tfquicksight.DataSetCreateResourceID(awsAccountID, aws.StringValue(dataSet.DataSetId))
+
+			log.Printf("[INFO] Deleting QuickSight Data Set (%s)", id)
+			r := resourceAwsQuickSightDataSet()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Data Sets: %w", err))
+	}
+
+	if err = testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Data Sets for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Data Sets sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSQuickSightDataSet_basic(t *testing.T) {
+	resourceName := "aws_quicksight_data_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dataset/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_set_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "import_mode", quicksight.DataSetImportModeSpice),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "physical_table_map.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "physical_table_map.*", map[string]string{
+						"physical_table_map_id":            rName,
+						"s3_source.#":                      "1",
+						"s3_source.0.input_columns.#":      "2",
+						"s3_source.0.input_columns.0.name": "id",
+						"s3_source.0.input_columns.0.type": quicksight.InputColumnDataTypeString,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "output_columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSet_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_data_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightDataSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSet_logicalTableMap(t *testing.T) {
+	resourceName := "aws_quicksight_data_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetConfigLogicalTableMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "logical_table_map.*", map[string]string{
+						"alias":                      "Group1",
+						"logical_table_map_id":       rName,
+						"data_transforms.#":          "2",
+						"source.0.physical_table_id": rName,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSet_tags(t *testing.T) {
+	resourceName := "aws_quicksight_data_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightDataSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_set" {
+			continue
+		}
+
+		awsAccountID, dataSetID, err := tfquicksight.DataSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.DataSetByID(context.Background(), conn, awsAccountID, dataSetID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightDataSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Data Set ID is set")
+		}
+
+		awsAccountID, dataSetID, err := tfquicksight.DataSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.DataSetByID(context.Background(), conn, awsAccountID, dataSetID)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightDataSetConfigPhysicalTableMap(rName string) string {
+	return fmt.Sprintf(`
+  physical_table_map {
+    physical_table_map_id = %[1]q
+
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+
+      input_columns {
+        name = "id"
+        type = "STRING"
+      }
+
+      input_columns {
+        name = "name"
+        type = "STRING"
+      }
+
+      upload_settings {
+        contains_header = true
+        delimiter       = ","
+        format          = "CSV"
+      }
+    }
+  }
+`, rName)
+}
+
+func testAccAWSQuickSightDataSetConfig(rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = "SPICE"
+
+%[2]s
+}
+`, rName, testAccAWSQuickSightDataSetConfigPhysicalTableMap(rName)))
+}
+
+func testAccAWSQuickSightDataSetConfigLogicalTableMap(rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = "SPICE"
+
+%[2]s
+
+  logical_table_map {
+    logical_table_map_id = %[1]q
+    alias                = "Group1"
+
+    data_transforms {
+      cast_column_type_operation {
+        column_name     = "id"
+        new_column_type = "INTEGER"
+      }
+    }
+
+    data_transforms {
+      rename_column_operation {
+        column_name     = "name"
+        new_column_name = "display_name"
+      }
+    }
+
+    source {
+      physical_table_id = %[1]q
+    }
+  }
+}
+`, rName, testAccAWSQuickSightDataSetConfigPhysicalTableMap(rName)))
+}
+
+func testAccAWSQuickSightDataSetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = "SPICE"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAWSQuickSightDataSetConfigPhysicalTableMap(rName), tagKey1, tagValue1))
+}
+
+func testAccAWSQuickSightDataSetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[1]q
+  import_mode = "SPICE"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAWSQuickSightDataSetConfigPhysicalTableMap(rName), tagKey1, tagValue1, tagKey2, tagValue2))
+}
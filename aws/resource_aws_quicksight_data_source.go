@@ -0,0 +1,1164 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightDataSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsQuickSightDataSourceCreate,
+		ReadContext:   resourceAwsQuickSightDataSourceRead,
+		UpdateContext: resourceAwsQuickSightDataSourceUpdate,
+		DeleteContext: resourceAwsQuickSightDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DataSourceStatusTimeout),
+			Update: schema.DefaultTimeout(waiter.DataSourceStatusTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_source_arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ValidateFunc:  validateArn,
+							ConflictsWith: []string{"credentials.0.credential_pair"},
+						},
+						"credential_pair": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"username": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+							ConflictsWith: []string{"credentials.0.copy_source_arn"},
+						},
+					},
+				},
+			},
+			"data_source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_elasticsearch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"athena": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"work_group": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"aurora":            quickSightDatabaseHostPortParametersSchema(),
+						"aurora_postgresql": quickSightDatabaseHostPortParametersSchema(),
+						"aws_iot_analytics": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"jira": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"maria_db":   quickSightDatabaseHostPortParametersSchema(),
+						"mysql":      quickSightDatabaseHostPortParametersSchema(),
+						"oracle":     quickSightDatabaseHostPortParametersSchema(),
+						"postgresql": quickSightDatabaseHostPortParametersSchema(),
+						"presto": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 128),
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+								},
+							},
+						},
+						"rds": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"instance_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"redshift": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 65535),
+									},
+								},
+							},
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"manifest_file_location": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.NoZeroValues,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_now": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_base_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+						"snowflake": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"warehouse": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 128),
+									},
+								},
+							},
+						},
+						"spark": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+								},
+							},
+						},
+						"sql_server": quickSightDatabaseHostPortParametersSchema(),
+						"teradata":   quickSightDatabaseHostPortParametersSchema(),
+						"twitter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_rows": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"permission": quickSightPermissionsSchema(),
+			"ssl_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_ssl": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSourceType_Values(), false),
+			},
+			"vpc_connection_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_connection_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func quickSightDatabaseHostPortParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"host": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+			},
+		},
+	}
+}
+
+func quickSightPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 64,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"actions": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 16,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"principal": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	dataSourceID := d.Get("data_source_id").(string)
+	id := tfquicksight.DataSourceCreateResourceID(awsAccountID, dataSourceID)
+
+	input := &quicksight.CreateDataSourceInput{
+		AwsAccountId:         aws.String(awsAccountID),
+		DataSourceId:         aws.String(dataSourceID),
+		DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+		Name:                 aws.String(d.Get("name").(string)),
+		Type:                 aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("credentials"); ok {
+		input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightPermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("ssl_properties"); ok {
+		input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_connection_properties"); ok {
+		input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().QuicksightTags()
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Data Source: %s", input)
+	_, err := conn.CreateDataSourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Data Source (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.DataSourceCreated(ctx, conn, awsAccountID, dataSourceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for QuickSight Data Source (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightDataSourceRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, dataSourceID, err := tfquicksight.DataSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSource, err := finder.DataSourceByID(ctx, conn, awsAccountID, dataSourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataSource.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("data_source_id", dataSource.DataSourceId)
+	d.Set("name", dataSource.Name)
+
+	if err := d.Set("parameters", flattenQuickSightDataSourceParameters(dataSource.DataSourceParameters)); err != nil {
+		return diag.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("ssl_properties", flattenQuickSightDataSourceSslProperties(dataSource.SslProperties)); err != nil {
+		return diag.Errorf("error setting ssl_properties: %s", err)
+	}
+
+	d.Set("type", dataSource.Type)
+
+	if err := d.Set("vpc_connection_properties", flattenQuickSightDataSourceVpcConnectionProperties(dataSource.VpcConnectionProperties)); err != nil {
+		return diag.Errorf("error setting vpc_connection_properties: %s", err)
+	}
+
+	permissionsOutput, err := conn.DescribeDataSourcePermissionsWithContext(ctx, &quicksight.DescribeDataSourcePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Data Source (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permissionsOutput.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, aws.StringValue(dataSource.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := tfquicksight.DataSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDataSourceInput{
+			AwsAccountId:         aws.String(awsAccountID),
+			DataSourceId:         aws.String(dataSourceID),
+			DataSourceParameters: expandQuickSightDataSourceParameters(d.Get("parameters").([]interface{})),
+			Name:                 aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("credentials"); ok {
+			input.Credentials = expandQuickSightDataSourceCredentials(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("ssl_properties"); ok {
+			input.SslProperties = expandQuickSightDataSourceSslProperties(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("vpc_connection_properties"); ok {
+			input.VpcConnectionProperties = expandQuickSightDataSourceVpcConnectionProperties(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Source (%s): %s", d.Id(), input)
+		_, err := conn.UpdateDataSourceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Source (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.DataSourceUpdated(ctx, conn, awsAccountID, dataSourceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for QuickSight Data Source (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		grants, revokes := diffQuickSightPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+		input := &quicksight.UpdateDataSourcePermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			DataSourceId: aws.String(dataSourceID),
+		}
+
+		if len(grants) > 0 {
+			input.GrantPermissions = grants
+		}
+
+		if len(revokes) > 0 {
+			input.RevokePermissions = revokes
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Data Source (%s) permissions: %s", d.Id(), input)
+		_, err := conn.UpdateDataSourcePermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Data Source (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Data Source (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightDataSourceRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, dataSourceID, err := tfquicksight.DataSourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Data Source: (%s)", d.Id())
+	_, err = conn.DeleteDataSourceWithContext(ctx, &quicksight.DeleteDataSourceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSourceId: aws.String(dataSourceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Data Source (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightDataSourceCredentials(tfList []interface{}) *quicksight.DataSourceCredentials {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.DataSourceCredentials{}
+
+	if v, ok := tfMap["copy_source_arn"].(string); ok && v != "" {
+		apiObject.CopySourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["credential_pair"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CredentialPair = &quicksight.CredentialPair{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDataSourceParameters(tfList []interface{}) *quicksight.DataSourceParameters {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &quicksight.DataSourceParameters{}
+
+	if tfMap := quickSightSingleBlock(tfMap["amazon_elasticsearch"]); tfMap != nil {
+		apiObject.AmazonElasticsearchParameters = &quicksight.AmazonElasticsearchParameters{
+			Domain: aws.String(tfMap["domain"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["athena"]); tfMap != nil {
+		apiObject.AthenaParameters = &quicksight.AthenaParameters{}
+
+		if v, ok := tfMap["work_group"].(string); ok && v != "" {
+			apiObject.AthenaParameters.WorkGroup = aws.String(v)
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["aurora"]); tfMap != nil {
+		apiObject.AuroraParameters = &quicksight.AuroraParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["aurora_postgresql"]); tfMap != nil {
+		apiObject.AuroraPostgreSqlParameters = &quicksight.AuroraPostgreSqlParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["aws_iot_analytics"]); tfMap != nil {
+		apiObject.AwsIotAnalyticsParameters = &quicksight.AwsIotAnalyticsParameters{
+			DataSetName: aws.String(tfMap["data_set_name"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["jira"]); tfMap != nil {
+		apiObject.JiraParameters = &quicksight.JiraParameters{
+			SiteBaseUrl: aws.String(tfMap["site_base_url"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["maria_db"]); tfMap != nil {
+		apiObject.MariaDbParameters = &quicksight.MariaDbParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["mysql"]); tfMap != nil {
+		apiObject.MySqlParameters = &quicksight.MySqlParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["oracle"]); tfMap != nil {
+		apiObject.OracleParameters = &quicksight.OracleParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["postgresql"]); tfMap != nil {
+		apiObject.PostgreSqlParameters = &quicksight.PostgreSqlParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["presto"]); tfMap != nil {
+		apiObject.PrestoParameters = &quicksight.PrestoParameters{
+			Catalog: aws.String(tfMap["catalog"].(string)),
+			Host:    aws.String(tfMap["host"].(string)),
+			Port:    aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["rds"]); tfMap != nil {
+		apiObject.RdsParameters = &quicksight.RdsParameters{
+			Database:   aws.String(tfMap["database"].(string)),
+			InstanceId: aws.String(tfMap["instance_id"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["redshift"]); tfMap != nil {
+		apiObject.RedshiftParameters = &quicksight.RedshiftParameters{
+			Database: aws.String(tfMap["database"].(string)),
+		}
+
+		if v, ok := tfMap["cluster_id"].(string); ok && v != "" {
+			apiObject.RedshiftParameters.ClusterId = aws.String(v)
+		}
+
+		if v, ok := tfMap["host"].(string); ok && v != "" {
+			apiObject.RedshiftParameters.Host = aws.String(v)
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.RedshiftParameters.Port = aws.Int64(int64(v))
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["s3"]); tfMap != nil {
+		apiObject.S3Parameters = &quicksight.S3Parameters{}
+
+		if tfMap := quickSightSingleBlock(tfMap["manifest_file_location"]); tfMap != nil {
+			apiObject.S3Parameters.ManifestFileLocation = &quicksight.ManifestFileLocation{
+				Bucket: aws.String(tfMap["bucket"].(string)),
+				Key:    aws.String(tfMap["key"].(string)),
+			}
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["service_now"]); tfMap != nil {
+		apiObject.ServiceNowParameters = &quicksight.ServiceNowParameters{
+			SiteBaseUrl: aws.String(tfMap["site_base_url"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["snowflake"]); tfMap != nil {
+		apiObject.SnowflakeParameters = &quicksight.SnowflakeParameters{
+			Database:  aws.String(tfMap["database"].(string)),
+			Host:      aws.String(tfMap["host"].(string)),
+			Warehouse: aws.String(tfMap["warehouse"].(string)),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["spark"]); tfMap != nil {
+		apiObject.SparkParameters = &quicksight.SparkParameters{
+			Host: aws.String(tfMap["host"].(string)),
+			Port: aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["sql_server"]); tfMap != nil {
+		apiObject.SqlServerParameters = &quicksight.SqlServerParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["teradata"]); tfMap != nil {
+		apiObject.TeradataParameters = &quicksight.TeradataParameters{
+			Database: aws.String(tfMap["database"].(string)),
+			Host:     aws.String(tfMap["host"].(string)),
+			Port:     aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["twitter"]); tfMap != nil {
+		apiObject.TwitterParameters = &quicksight.TwitterParameters{
+			MaxRows: aws.Int64(int64(tfMap["max_rows"].(int))),
+			Query:   aws.String(tfMap["query"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenQuickSightDataSourceParameters(apiObject *quicksight.DataSourceParameters) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AmazonElasticsearchParameters; v != nil {
+		tfMap["amazon_elasticsearch"] = []interface{}{map[string]interface{}{
+			"domain": aws.StringValue(v.Domain),
+		}}
+	}
+
+	if v := apiObject.AthenaParameters; v != nil {
+		tfMap["athena"] = []interface{}{map[string]interface{}{
+			"work_group": aws.StringValue(v.WorkGroup),
+		}}
+	}
+
+	if v := apiObject.AuroraParameters; v != nil {
+		tfMap["aurora"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.AuroraPostgreSqlParameters; v != nil {
+		tfMap["aurora_postgresql"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.AwsIotAnalyticsParameters; v != nil {
+		tfMap["aws_iot_analytics"] = []interface{}{map[string]interface{}{
+			"data_set_name": aws.StringValue(v.DataSetName),
+		}}
+	}
+
+	if v := apiObject.JiraParameters; v != nil {
+		tfMap["jira"] = []interface{}{map[string]interface{}{
+			"site_base_url": aws.StringValue(v.SiteBaseUrl),
+		}}
+	}
+
+	if v := apiObject.MariaDbParameters; v != nil {
+		tfMap["maria_db"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.MySqlParameters; v != nil {
+		tfMap["mysql"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.OracleParameters; v != nil {
+		tfMap["oracle"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.PostgreSqlParameters; v != nil {
+		tfMap["postgresql"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.PrestoParameters; v != nil {
+		tfMap["presto"] = []interface{}{map[string]interface{}{
+			"catalog": aws.StringValue(v.Catalog),
+			"host":    aws.StringValue(v.Host),
+			"port":    aws.Int64Value(v.Port),
+		}}
+	}
+
+	if v := apiObject.RdsParameters; v != nil {
+		tfMap["rds"] = []interface{}{map[string]interface{}{
+			"database":    aws.StringValue(v.Database),
+			"instance_id": aws.StringValue(v.InstanceId),
+		}}
+	}
+
+	if v := apiObject.RedshiftParameters; v != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"cluster_id": aws.StringValue(v.ClusterId),
+			"database":   aws.StringValue(v.Database),
+			"host":       aws.StringValue(v.Host),
+			"port":       aws.Int64Value(v.Port),
+		}}
+	}
+
+	if v := apiObject.S3Parameters; v != nil {
+		s3 := map[string]interface{}{}
+
+		if v := v.ManifestFileLocation; v != nil {
+			s3["manifest_file_location"] = []interface{}{map[string]interface{}{
+				"bucket": aws.StringValue(v.Bucket),
+				"key":    aws.StringValue(v.Key),
+			}}
+		}
+
+		tfMap["s3"] = []interface{}{s3}
+	}
+
+	if v := apiObject.ServiceNowParameters; v != nil {
+		tfMap["service_now"] = []interface{}{map[string]interface{}{
+			"site_base_url": aws.StringValue(v.SiteBaseUrl),
+		}}
+	}
+
+	if v := apiObject.SnowflakeParameters; v != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"database":  aws.StringValue(v.Database),
+			"host":      aws.StringValue(v.Host),
+			"warehouse": aws.StringValue(v.Warehouse),
+		}}
+	}
+
+	if v := apiObject.SparkParameters; v != nil {
+		tfMap["spark"] = []interface{}{map[string]interface{}{
+			"host": aws.StringValue(v.Host),
+			"port": aws.Int64Value(v.Port),
+		}}
+	}
+
+	if v := apiObject.SqlServerParameters; v != nil {
+		tfMap["sql_server"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.TeradataParameters; v != nil {
+		tfMap["teradata"] = flattenQuickSightDatabaseHostPortParameters(v.Database, v.Host, v.Port)
+	}
+
+	if v := apiObject.TwitterParameters; v != nil {
+		tfMap["twitter"] = []interface{}{map[string]interface{}{
+			"max_rows": aws.Int64Value(v.MaxRows),
+			"query":    aws.StringValue(v.Query),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenQuickSightDatabaseHostPortParameters(database, host *string, port *int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"database": aws.StringValue(database),
+		"host":     aws.StringValue(host),
+		"port":     aws.Int64Value(port),
+	}}
+}
+
+func expandQuickSightDataSourceSslProperties(tfList []interface{}) *quicksight.SslProperties {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	return &quicksight.SslProperties{
+		DisableSsl: aws.Bool(tfMap["disable_ssl"].(bool)),
+	}
+}
+
+func flattenQuickSightDataSourceSslProperties(apiObject *quicksight.SslProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"disable_ssl": aws.BoolValue(apiObject.DisableSsl),
+	}}
+}
+
+func expandQuickSightDataSourceVpcConnectionProperties(tfList []interface{}) *quicksight.VpcConnectionProperties {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	return &quicksight.VpcConnectionProperties{
+		VpcConnectionArn: aws.String(tfMap["vpc_connection_arn"].(string)),
+	}
+}
+
+func flattenQuickSightDataSourceVpcConnectionProperties(apiObject *quicksight.VpcConnectionProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"vpc_connection_arn": aws.StringValue(apiObject.VpcConnectionArn),
+	}}
+}
+
+// quickSightSingleBlock returns the map for a MaxItems: 1 list block, or nil if the block is not configured.
+func quickSightSingleBlock(v interface{}) map[string]interface{} {
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	return tfMap
+}
+
+func expandQuickSightPermissions(tfList []interface{}) []*quicksight.ResourcePermission {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.ResourcePermission
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.ResourcePermission{
+			Actions:   expandStringSet(tfMap["actions"].(*schema.Set)),
+			Principal: aws.String(tfMap["principal"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenQuickSightPermissions(apiObjects []*quicksight.ResourcePermission) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"actions":   flattenStringSet(apiObject.Actions),
+			"principal": aws.StringValue(apiObject.Principal),
+		})
+	}
+
+	return tfList
+}
+
+// diffQuickSightPermissions returns the permissions to grant and to revoke to get from the old to the new permission blocks.
+func diffQuickSightPermissions(o, n []interface{}) ([]*quicksight.ResourcePermission, []*quicksight.ResourcePermission) {
+	oldPermissions := make(map[string]*schema.Set)
+	for _, v := range expandQuickSightPermissions(o) {
+		oldPermissions[aws.StringValue(v.Principal)] = flattenStringSet(v.Actions)
+	}
+
+	newPermissions := make(map[string]*schema.Set)
+	for _, v := range expandQuickSightPermissions(n) {
+		newPermissions[aws.StringValue(v.Principal)] = flattenStringSet(v.Actions)
+	}
+
+	var grants, revokes []*quicksight.ResourcePermission
+
+	for principal, newActions := range newPermissions {
+		oldActions, ok := oldPermissions[principal]
+
+		if !ok {
+			grants = append(grants, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(newActions),
+				Principal: aws.String(principal),
+			})
+
+			continue
+		}
+
+		if v := newActions.Difference(oldActions); v.Len() > 0 {
+			grants = append(grants, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(v),
+				Principal: aws.String(principal),
+			})
+		}
+
+		if v := oldActions.Difference(newActions); v.Len() > 0 {
+			revokes = append(revokes, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(v),
+				Principal: aws.String(principal),
+			})
+		}
+	}
+
+	for principal, oldActions := range oldPermissions {
+		if _, ok := newPermissions[principal]; !ok {
+			revokes = append(revokes, &quicksight.ResourcePermission{
+				Actions:   expandStringSet(oldActions),
+				Principal: aws.String(principal),
+			})
+		}
+	}
+
+	return grants, revokes
+}
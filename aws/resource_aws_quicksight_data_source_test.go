@@ -0,0 +1,434 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+		Name: "aws_quicksight_data_source",
+		F:    testSweepQuickSightDataSources,
+		Dependencies: []string{
+			"aws_quicksight_data_set",
+		},
+	})
+}
+
+func testSweepQuickSightDataSources(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).quicksightconn
+	awsAccountID := client.(*AWSClient).accountid
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &quicksight.ListDataSourcesInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	err = conn.ListDataSourcesPages(input, func(page *quicksight.ListDataSourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dataSource := range page.DataSources {
+			if dataSource == nil {
+				continue
+			}
+
+			id := tfquicksight.DataSourceCreateResourceID(awsAccountID, aws.StringValue(dataSource.DataSourceId))
+
+			log.Printf("[INFO] Deleting QuickSight Data Source (%s)", id)
+			r := resourceAwsQuickSightDataSource()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Data Sources: %w", err))
+	}
+
+	if err = testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Data Sources for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Data Sources sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSQuickSightDataSource_basic(t *testing.T) {
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("datasource/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_source_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.s3.0.manifest_file_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameters.0.s3.0.manifest_file_location.0.key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeS3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightDataSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_permissions(t *testing.T) {
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfigPermissions(rName, `["quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.principal", "aws_quicksight_user.test", "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"actions.#": "3",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigPermissions(rName, `["quicksight:DescribeDataSource", "quicksight:DescribeDataSourcePermissions", "quicksight:PassDataSource", "quicksight:UpdateDataSource", "quicksight:DeleteDataSource", "quicksight:UpdateDataSourcePermissions"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"actions.#": "6",
+					}),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightDataSource_tags(t *testing.T) {
+	resourceName := "aws_quicksight_data_source.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightDataSourceConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightDataSourceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_data_source" {
+			continue
+		}
+
+		awsAccountID, dataSourceID, err := tfquicksight.DataSourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.DataSourceByID(context.Background(), conn, awsAccountID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Data Source %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightDataSourceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Data Source ID is set")
+		}
+
+		awsAccountID, dataSourceID, err := tfquicksight.DataSourceParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.DataSourceByID(context.Background(), conn, awsAccountID, dataSourceID)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "public-read"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test_data" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-data.csv"
+  content = <<EOF
+id,name
+1,alpha
+2,beta
+EOF
+  acl     = "public-read"
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-manifest.json"
+  content = jsonencode({
+    fileLocations = [
+      {
+        URIs = [
+          "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test_data.key}"
+        ]
+      }
+    ]
+    globalUploadSettings = {
+      format         = "CSV"
+      delimiter      = ","
+      containsHeader = "true"
+    }
+  })
+  acl     = "public-read"
+}
+`, rName)
+}
+
+func testAccAWSQuickSightDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+  type           = "S3"
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSQuickSightDataSourceConfigPermissions(rName, actions string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+  type           = "S3"
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  permission {
+    actions   = %[2]s
+    principal = aws_quicksight_user.test.arn
+  }
+}
+
+resource "aws_quicksight_user" "test" {
+  user_name     = "%[1]s-reader"
+  email         = %[3]q
+  identity_type = "QUICKSIGHT"
+  user_role     = "AUTHOR"
+}
+`, rName, actions, testAccDefaultEmailAddress))
+}
+
+func testAccAWSQuickSightDataSourceConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+  type           = "S3"
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSQuickSightDataSourceConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSourceConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[1]q
+  type           = "S3"
+
+  parameters {
+    s3 {
+      manifest_file_location {
+        bucket = aws_s3_bucket.test.bucket
+        key    = aws_s3_bucket_object.test.key
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
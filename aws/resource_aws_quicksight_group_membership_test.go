@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSQuickSightGroupMembership_basic(t *testing.T) {
+	resourceName := "aws_quicksight_group_membership.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupMembershipConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_quicksight_user.test", "arn"),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_quicksight_group.test", "group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_name", "aws_quicksight_user.test", "user_name"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightGroupMembership_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_group_membership.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightGroupMembershipConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightGroupMembershipExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightGroupMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightGroupMembershipDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_group_membership" {
+			continue
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := tfquicksight.GroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.GroupMembership(context.Background(), conn, awsAccountID, namespace, groupName, memberName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Group Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Group Membership ID is set")
+		}
+
+		awsAccountID, namespace, groupName, memberName, err := tfquicksight.GroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.GroupMembership(context.Background(), conn, awsAccountID, namespace, groupName, memberName)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightGroupMembershipConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "test" {
+  group_name = %[1]q
+}
+
+resource "aws_quicksight_user" "test" {
+  user_name     = %[1]q
+  email         = %[2]q
+  identity_type = "QUICKSIGHT"
+  user_role     = "READER"
+}
+
+resource "aws_quicksight_group_membership" "test" {
+  group_name  = aws_quicksight_group.test.group_name
+  member_name = aws_quicksight_user.test.user_name
+}
+`, rName, testAccDefaultEmailAddress)
+}
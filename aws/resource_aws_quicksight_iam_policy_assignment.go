@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	quickSightIAMPolicyAssignmentIdentityTypeGroup = "group"
+	quickSightIAMPolicyAssignmentIdentityTypeUser  = "user"
+)
+
+func resourceAwsQuickSightIAMPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsQuickSightIAMPolicyAssignmentCreate,
+		ReadContext:   resourceAwsQuickSightIAMPolicyAssignmentRead,
+		UpdateContext: resourceAwsQuickSightIAMPolicyAssignmentUpdate,
+		DeleteContext: resourceAwsQuickSightIAMPolicyAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assignment_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"assignment_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.AssignmentStatus_Values(), false),
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"identities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						quickSightIAMPolicyAssignmentIdentityTypeGroup: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						quickSightIAMPolicyAssignmentIdentityTypeUser: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+				ValidateFunc: validation.StringInSlice([]string{
+					"default",
+				}, false),
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightIAMPolicyAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	namespace := d.Get("namespace").(string)
+	assignmentName := d.Get("assignment_name").(string)
+	id := tfquicksight.IAMPolicyAssignmentCreateResourceID(awsAccountID, namespace, assignmentName)
+
+	input := &quicksight.CreateIAMPolicyAssignmentInput{
+		AssignmentName:   aws.String(assignmentName),
+		AssignmentStatus: aws.String(d.Get("assignment_status").(string)),
+		AwsAccountId:     aws.String(awsAccountID),
+		Namespace:        aws.String(namespace),
+	}
+
+	if v, ok := d.GetOk("identities"); ok {
+		input.Identities = expandQuickSightIAMPolicyAssignmentIdentities(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight IAM Policy Assignment: %s", input)
+	_, err := conn.CreateIAMPolicyAssignmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight IAM Policy Assignment (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsQuickSightIAMPolicyAssignmentRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightIAMPolicyAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, assignmentName, err := tfquicksight.IAMPolicyAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment, err := finder.IAMPolicyAssignmentByName(ctx, conn, awsAccountID, namespace, assignmentName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight IAM Policy Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight IAM Policy Assignment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assignment_id", assignment.AssignmentId)
+	d.Set("assignment_name", assignment.AssignmentName)
+	d.Set("assignment_status", assignment.AssignmentStatus)
+	d.Set("aws_account_id", awsAccountID)
+
+	if err := d.Set("identities", flattenQuickSightIAMPolicyAssignmentIdentities(assignment.Identities)); err != nil {
+		return diag.Errorf("error setting identities: %s", err)
+	}
+
+	d.Set("namespace", namespace)
+	d.Set("policy_arn", assignment.PolicyArn)
+
+	return nil
+}
+
+func resourceAwsQuickSightIAMPolicyAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, assignmentName, err := tfquicksight.IAMPolicyAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &quicksight.UpdateIAMPolicyAssignmentInput{
+		AssignmentName:   aws.String(assignmentName),
+		AssignmentStatus: aws.String(d.Get("assignment_status").(string)),
+		AwsAccountId:     aws.String(awsAccountID),
+		Namespace:        aws.String(namespace),
+	}
+
+	if d.HasChange("identities") {
+		input.Identities = expandQuickSightIAMPolicyAssignmentIdentities(d.Get("identities").([]interface{}))
+	}
+
+	if d.HasChange("policy_arn") {
+		input.PolicyArn = aws.String(d.Get("policy_arn").(string))
+	}
+
+	log.Printf("[DEBUG] Updating QuickSight IAM Policy Assignment (%s): %s", d.Id(), input)
+	_, err = conn.UpdateIAMPolicyAssignmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating QuickSight IAM Policy Assignment (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightIAMPolicyAssignmentRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightIAMPolicyAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, namespace, assignmentName, err := tfquicksight.IAMPolicyAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight IAM Policy Assignment: (%s)", d.Id())
+	_, err = conn.DeleteIAMPolicyAssignmentWithContext(ctx, &quicksight.DeleteIAMPolicyAssignmentInput{
+		AssignmentName: aws.String(assignmentName),
+		AwsAccountId:   aws.String(awsAccountID),
+		Namespace:      aws.String(namespace),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight IAM Policy Assignment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightIAMPolicyAssignmentIdentities(tfList []interface{}) map[string][]*string {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := make(map[string][]*string)
+
+	for _, k := range []string{quickSightIAMPolicyAssignmentIdentityTypeGroup, quickSightIAMPolicyAssignmentIdentityTypeUser} {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			apiObject[k] = expandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenQuickSightIAMPolicyAssignmentIdentities(apiObject map[string][]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for _, k := range []string{quickSightIAMPolicyAssignmentIdentityTypeGroup, quickSightIAMPolicyAssignmentIdentityTypeUser} {
+		if v, ok := apiObject[k]; ok {
+			tfMap[k] = flattenStringSet(v)
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSQuickSightIAMPolicyAssignment_basic(t *testing.T) {
+	resourceName := "aws_quicksight_iam_policy_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightIAMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightIAMPolicyAssignmentConfig(rName, quicksight.AssignmentStatusDraft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightIAMPolicyAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "assignment_id"),
+					resource.TestCheckResourceAttr(resourceName, "assignment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "assignment_status", quicksight.AssignmentStatusDraft),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "identities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightIAMPolicyAssignment_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_iam_policy_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightIAMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightIAMPolicyAssignmentConfig(rName, quicksight.AssignmentStatusDraft),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightIAMPolicyAssignmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightIAMPolicyAssignment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightIAMPolicyAssignment_identities(t *testing.T) {
+	resourceName := "aws_quicksight_iam_policy_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightIAMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightIAMPolicyAssignmentConfigIdentities(rName, quicksight.AssignmentStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightIAMPolicyAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment_status", quicksight.AssignmentStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "identities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identities.0.group.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "identities.0.group.*", "aws_quicksight_group.test", "group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_arn", "aws_iam_policy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSQuickSightIAMPolicyAssignmentConfigIdentities(rName, quicksight.AssignmentStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightIAMPolicyAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment_status", quicksight.AssignmentStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSQuickSightIAMPolicyAssignmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_iam_policy_assignment" {
+			continue
+		}
+
+		awsAccountID, namespace, assignmentName, err := tfquicksight.IAMPolicyAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.IAMPolicyAssignmentByName(context.Background(), conn, awsAccountID, namespace, assignmentName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight IAM Policy Assignment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightIAMPolicyAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight IAM Policy Assignment ID is set")
+		}
+
+		awsAccountID, namespace, assignmentName, err := tfquicksight.IAMPolicyAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.IAMPolicyAssignmentByName(context.Background(), conn, awsAccountID, namespace, assignmentName)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightIAMPolicyAssignmentConfig(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_iam_policy_assignment" "test" {
+  assignment_name   = %[1]q
+  assignment_status = %[2]q
+}
+`, rName, status)
+}
+
+func testAccAWSQuickSightIAMPolicyAssignmentConfigIdentities(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_group" "test" {
+  group_name = %[1]q
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:ListAllMyBuckets"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_quicksight_iam_policy_assignment" "test" {
+  assignment_name   = %[1]q
+  assignment_status = %[2]q
+  policy_arn        = aws_iam_policy.test.arn
+
+  identities {
+    group = [aws_quicksight_group.test.group_name]
+  }
+}
+`, rName, status)
+}
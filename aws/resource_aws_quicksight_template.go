@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsQuickSightTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsQuickSightTemplateCreate,
+		ReadContext:   resourceAwsQuickSightTemplateRead,
+		UpdateContext: resourceAwsQuickSightTemplateUpdate,
+		DeleteContext: resourceAwsQuickSightTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.TemplateVersionTimeout),
+			Update: schema.DefaultTimeout(waiter.TemplateVersionTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"permission": quickSightPermissionsSchema(),
+			"source_entity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_analysis": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"source_entity.0.source_analysis", "source_entity.0.source_template"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"data_set_references": quickSightDataSetReferencesSchema(),
+								},
+							},
+						},
+						"source_template": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"source_entity.0.source_analysis", "source_entity.0.source_template"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func quickSightDataSetReferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"data_set_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"data_set_placeholder": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsQuickSightTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+	templateID := d.Get("template_id").(string)
+	id := tfquicksight.TemplateCreateResourceID(awsAccountID, templateID)
+
+	input := &quicksight.CreateTemplateInput{
+		AwsAccountId:       aws.String(awsAccountID),
+		Name:               aws.String(d.Get("name").(string)),
+		SourceEntity:       expandQuickSightTemplateSourceEntity(d.Get("source_entity").([]interface{})),
+		TemplateId:         aws.String(templateID),
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightPermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().QuicksightTags()
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Template: %s", input)
+	_, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Template (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waiter.TemplateVersionCreated(ctx, conn, awsAccountID, templateID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for QuickSight Template (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsQuickSightTemplateRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	awsAccountID, templateID, err := tfquicksight.TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := finder.TemplateByID(ctx, conn, awsAccountID, templateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("created_time", aws.TimeValue(template.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(template.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", template.Name)
+	d.Set("template_id", template.TemplateId)
+
+	if version := template.Version; version != nil {
+		d.Set("source_entity_arn", version.SourceEntityArn)
+		d.Set("status", version.Status)
+		d.Set("version_description", version.Description)
+		d.Set("version_number", version.VersionNumber)
+	}
+
+	permissionsOutput, err := conn.DescribeTemplatePermissionsWithContext(ctx, &quicksight.DescribeTemplatePermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Template (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permissionsOutput.Permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := keyvaluetags.QuicksightListTags(conn, aws.StringValue(template.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsQuickSightTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, templateID, err := tfquicksight.TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateTemplateInput{
+			AwsAccountId:       aws.String(awsAccountID),
+			Name:               aws.String(d.Get("name").(string)),
+			SourceEntity:       expandQuickSightTemplateSourceEntity(d.Get("source_entity").([]interface{})),
+			TemplateId:         aws.String(templateID),
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Template (%s): %s", d.Id(), input)
+		_, err := conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waiter.TemplateVersionCreated(ctx, conn, awsAccountID, templateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for QuickSight Template (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		grants, revokes := diffQuickSightPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+		input := &quicksight.UpdateTemplatePermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			TemplateId:   aws.String(templateID),
+		}
+
+		if len(grants) > 0 {
+			input.GrantPermissions = grants
+		}
+
+		if len(revokes) > 0 {
+			input.RevokePermissions = revokes
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Template (%s) permissions: %s", d.Id(), input)
+		_, err := conn.UpdateTemplatePermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.QuicksightUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsQuickSightTemplateRead(ctx, d, meta)
+}
+
+func resourceAwsQuickSightTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).quicksightconn
+
+	awsAccountID, templateID, err := tfquicksight.TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting QuickSight Template: (%s)", d.Id())
+	_, err = conn.DeleteTemplateWithContext(ctx, &quicksight.DeleteTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandQuickSightTemplateSourceEntity(tfList []interface{}) *quicksight.TemplateSourceEntity {
+	tfMap := quickSightSingleBlock(tfList)
+
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.TemplateSourceEntity{}
+
+	if tfMap := quickSightSingleBlock(tfMap["source_analysis"]); tfMap != nil {
+		apiObject.SourceAnalysis = &quicksight.TemplateSourceAnalysis{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandQuickSightDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	if tfMap := quickSightSingleBlock(tfMap["source_template"]); tfMap != nil {
+		apiObject.SourceTemplate = &quicksight.TemplateSourceTemplate{
+			Arn: aws.String(tfMap["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandQuickSightDataSetReferences(tfList []interface{}) []*quicksight.DataSetReference {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*quicksight.DataSetReference
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.DataSetReference{
+			DataSetArn:         aws.String(tfMap["data_set_arn"].(string)),
+			DataSetPlaceholder: aws.String(tfMap["data_set_placeholder"].(string)),
+		})
+	}
+
+	return apiObjects
+}
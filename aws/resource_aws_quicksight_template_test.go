@@ -0,0 +1,368 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfquicksight "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/quicksight/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
+		Name: "aws_quicksight_template",
+		F:    testSweepQuickSightTemplates,
+		Dependencies: []string{
+			"aws_quicksight_dashboard",
+		},
+	})
+}
+
+func testSweepQuickSightTemplates(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).quicksightconn
+	awsAccountID := client.(*AWSClient).accountid
+	sweepResources := make([]*testSweepResource, 0)
+	var errs *multierror.Error
+
+	input := &quicksight.ListTemplatesInput{
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	err = conn.ListTemplatesPages(input, func(page *quicksight.ListTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.TemplateSummaryList {
+			if template == nil {
+				continue
+			}
+
+			id := tfquicksight.TemplateCreateResourceID(awsAccountID, aws.StringValue(template.TemplateId))
+
+			log.Printf("[INFO] Deleting QuickSight Template (%s)", id)
+			r := resourceAwsQuickSightTemplate()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Templates: %w", err))
+	}
+
+	if err = testSweepResourceOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Templates for %s: %w", region, err))
+	}
+
+	if testSweepSkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Templates sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func TestAccAWSQuickSightTemplate_basic(t *testing.T) {
+	resourceName := "aws_quicksight_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("template/%s", rName)),
+					testAccCheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Version 1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_entity"},
+			},
+			{
+				Config: testAccAWSQuickSightTemplateConfig(rName, "Version 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Version 2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightTemplate_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsQuickSightTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightTemplate_sourceTemplate(t *testing.T) {
+	resourceName := "aws_quicksight_template.copy"
+	sourceResourceName := "aws_quicksight_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightTemplateConfigSourceTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_entity.0.source_template.0.arn", sourceResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSQuickSightTemplate_tags(t *testing.T) {
+	resourceName := "aws_quicksight_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSQuickSightAnalysis(t) },
+		ErrorCheck:   testAccErrorCheck(t, quicksight.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSQuickSightTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSQuickSightTemplateConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_entity"},
+			},
+			{
+				Config: testAccAWSQuickSightTemplateConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSQuickSightTemplateConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSQuickSightTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckAWSQuickSightAnalysis skips the test unless an existing analysis is supplied,
+// as analyses cannot be created through the QuickSight API.
+func testAccPreCheckAWSQuickSightAnalysis(t *testing.T) {
+	if os.Getenv("AWS_QUICKSIGHT_ANALYSIS_ARN") == "" {
+		t.Skip("AWS_QUICKSIGHT_ANALYSIS_ARN env var must be set for QuickSight Template and Dashboard acceptance tests.")
+	}
+
+	if os.Getenv("AWS_QUICKSIGHT_ANALYSIS_DATA_SET_PLACEHOLDER") == "" {
+		t.Skip("AWS_QUICKSIGHT_ANALYSIS_DATA_SET_PLACEHOLDER env var must be set for QuickSight Template and Dashboard acceptance tests.")
+	}
+}
+
+func testAccCheckAWSQuickSightTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_template" {
+			continue
+		}
+
+		awsAccountID, templateID, err := tfquicksight.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TemplateByID(context.Background(), conn, awsAccountID, templateID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSQuickSightTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Template ID is set")
+		}
+
+		awsAccountID, templateID, err := tfquicksight.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+
+		_, err = finder.TemplateByID(context.Background(), conn, awsAccountID, templateID)
+
+		return err
+	}
+}
+
+func testAccAWSQuickSightTemplateConfigSourceAnalysis() string {
+	return fmt.Sprintf(`
+  source_entity {
+    source_analysis {
+      arn = %[1]q
+
+      data_set_references {
+        data_set_arn         = aws_quicksight_data_set.test.arn
+        data_set_placeholder = %[2]q
+      }
+    }
+  }
+`, os.Getenv("AWS_QUICKSIGHT_ANALYSIS_ARN"), os.Getenv("AWS_QUICKSIGHT_ANALYSIS_DATA_SET_PLACEHOLDER"))
+}
+
+func testAccAWSQuickSightTemplateConfig(rName, versionDescription string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSetConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[1]q
+  version_description = %[2]q
+
+%[3]s
+}
+`, rName, versionDescription, testAccAWSQuickSightTemplateConfigSourceAnalysis()))
+}
+
+func testAccAWSQuickSightTemplateConfigSourceTemplate(rName string) string {
+	return composeConfig(
+		testAccAWSQuickSightTemplateConfig(rName, "Version 1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "copy" {
+  template_id         = "%[1]s-copy"
+  name                = "%[1]s-copy"
+  version_description = "Copy"
+
+  source_entity {
+    source_template {
+      arn = aws_quicksight_template.test.arn
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSQuickSightTemplateConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSetConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[1]q
+  version_description = "Version 1"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAWSQuickSightTemplateConfigSourceAnalysis(), tagKey1, tagValue1))
+}
+
+func testAccAWSQuickSightTemplateConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSQuickSightDataSetConfig(rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[1]q
+  version_description = "Version 1"
+
+%[2]s
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAWSQuickSightTemplateConfigSourceAnalysis(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
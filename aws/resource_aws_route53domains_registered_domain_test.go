@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+)
+
+const (
+	EnvVarRoute53DomainsDomainName             = "ROUTE53DOMAINS_DOMAIN_NAME"
+	EnvVarRoute53DomainsDomainNameMessageError = "Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set. " +
+		"To properly test managing a Route 53 Domains registered domain, " +
+		"the name of a domain registered with Route 53 in the testing account must be provided."
+)
+
+// The tests modify a single registered domain, so they run serially.
+func TestAccAWSRoute53DomainsRegisteredDomain_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"tags":           testAccAwsRoute53DomainsRegisteredDomain_Tags,
+		"autoRenew":      testAccAwsRoute53DomainsRegisteredDomain_AutoRenew,
+		"contactPrivacy": testAccAwsRoute53DomainsRegisteredDomain_ContactPrivacy,
+		"nameServers":    testAccAwsRoute53DomainsRegisteredDomain_NameServers,
+		"transferLock":   testAccAwsRoute53DomainsRegisteredDomain_TransferLock,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccAwsRoute53DomainsRegisteredDomain_Tags(t *testing.T) {
+	domainName := envvar.TestSkipIfEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53domains.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsRoute53DomainsRegisteredDomain_AutoRenew(t *testing.T) {
+	domainName := envvar.TestSkipIfEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53domains.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsRoute53DomainsRegisteredDomain_ContactPrivacy(t *testing.T) {
+	domainName := envvar.TestSkipIfEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53domains.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsRoute53DomainsRegisteredDomain_NameServers(t *testing.T) {
+	domainName := envvar.TestSkipIfEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53domains.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigNameServers(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name_server.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsRoute53DomainsRegisteredDomain_TransferLock(t *testing.T) {
+	domainName := envvar.TestSkipIfEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, route53domains.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigNameServers(domainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  dynamic "name_server" {
+    for_each = data.aws_route53_zone.test.name_servers
+
+    content {
+      name = name_server.value
+    }
+  }
+}
+`, domainName)
+}
+
+func testAccAwsRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
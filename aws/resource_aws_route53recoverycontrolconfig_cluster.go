@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryControlConfigCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryControlConfigClusterCreate,
+		ReadContext:   resourceAwsRoute53RecoveryControlConfigClusterRead,
+		DeleteContext: resourceAwsRoute53RecoveryControlConfigClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ClusterCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ClusterDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryControlConfigClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	name := d.Get("name").(string)
+	input := &r53rcc.CreateClusterInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		ClusterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Control Config Cluster: %s", input)
+	output, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Control Config Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Cluster.ClusterArn))
+
+	if _, err := waiter.ClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigClusterRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	cluster, err := finder.ClusterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Control Config Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Control Config Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", cluster.ClusterArn)
+	if err := d.Set("cluster_endpoints", flattenRoute53RecoveryControlConfigClusterEndpoints(cluster.ClusterEndpoints)); err != nil {
+		return diag.Errorf("error setting cluster_endpoints: %s", err)
+	}
+	d.Set("name", cluster.Name)
+	d.Set("status", cluster.Status)
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryControlConfigClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Control Config Cluster: %s", d.Id())
+	_, err := conn.DeleteClusterWithContext(ctx, &r53rcc.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Control Config Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenRoute53RecoveryControlConfigClusterEndpoint(apiObject *r53rcc.ClusterEndpoint) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Endpoint; v != nil {
+		tfMap["endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53RecoveryControlConfigClusterEndpoints(apiObjects []*r53rcc.ClusterEndpoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenRoute53RecoveryControlConfigClusterEndpoint(apiObject))
+	}
+
+	return tfList
+}
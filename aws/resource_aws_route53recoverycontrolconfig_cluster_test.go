@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSRoute53RecoveryControlConfigCluster_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigClusterExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "route53-recovery-control", regexp.MustCompile(`cluster/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoints.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigCluster_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigClusterConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigClusterExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53RecoveryControlConfigCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53recoverycontrolconfig_cluster" {
+			continue
+		}
+
+		_, err := finder.ClusterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Recovery Control Config Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Recovery Control Config Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+		_, err := finder.ClusterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRoute53RecoveryControlConfigClusterConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+`, rName)
+}
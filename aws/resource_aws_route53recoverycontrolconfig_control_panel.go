@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryControlConfigControlPanel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryControlConfigControlPanelCreate,
+		ReadContext:   resourceAwsRoute53RecoveryControlConfigControlPanelRead,
+		UpdateContext: resourceAwsRoute53RecoveryControlConfigControlPanelUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryControlConfigControlPanelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ControlPanelCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ControlPanelDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"default_control_panel": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"routing_control_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryControlConfigControlPanelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	name := d.Get("name").(string)
+	input := &r53rcc.CreateControlPanelInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ClusterArn:       aws.String(d.Get("cluster_arn").(string)),
+		ControlPanelName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Control Config Control Panel: %s", input)
+	output, err := conn.CreateControlPanelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Control Config Control Panel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ControlPanel.ControlPanelArn))
+
+	if _, err := waiter.ControlPanelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Control Panel (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigControlPanelRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigControlPanelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	controlPanel, err := finder.ControlPanelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Control Config Control Panel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Control Config Control Panel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", controlPanel.ControlPanelArn)
+	d.Set("cluster_arn", controlPanel.ClusterArn)
+	d.Set("default_control_panel", controlPanel.DefaultControlPanel)
+	d.Set("name", controlPanel.Name)
+	d.Set("routing_control_count", controlPanel.RoutingControlCount)
+	d.Set("status", controlPanel.Status)
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryControlConfigControlPanelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	input := &r53rcc.UpdateControlPanelInput{
+		ControlPanelArn:  aws.String(d.Id()),
+		ControlPanelName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Recovery Control Config Control Panel: %s", input)
+	_, err := conn.UpdateControlPanelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Route 53 Recovery Control Config Control Panel (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigControlPanelRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigControlPanelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Control Config Control Panel: %s", d.Id())
+	_, err := conn.DeleteControlPanelWithContext(ctx, &r53rcc.DeleteControlPanelInput{
+		ControlPanelArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Control Config Control Panel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ControlPanelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Control Panel (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSRoute53RecoveryControlConfigControlPanel_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_control_panel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigControlPanelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigControlPanelConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigControlPanelExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "route53-recovery-control", regexp.MustCompile(`controlpanel/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", "aws_route53recoverycontrolconfig_cluster.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "default_control_panel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigControlPanel_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_control_panel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigControlPanelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigControlPanelConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigControlPanelExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53RecoveryControlConfigControlPanel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigControlPanel_Name(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_control_panel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigControlPanelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigControlPanelConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigControlPanelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigControlPanelConfig(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigControlPanelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigControlPanelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53recoverycontrolconfig_control_panel" {
+			continue
+		}
+
+		_, err := finder.ControlPanelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Recovery Control Config Control Panel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigControlPanelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Recovery Control Config Control Panel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+		_, err := finder.ControlPanelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRoute53RecoveryControlConfigControlPanelConfig(rName, controlPanelName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_control_panel" "test" {
+  name        = %[2]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+`, rName, controlPanelName)
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryControlConfigRoutingControl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryControlConfigRoutingControlCreate,
+		ReadContext:   resourceAwsRoute53RecoveryControlConfigRoutingControlRead,
+		UpdateContext: resourceAwsRoute53RecoveryControlConfigRoutingControlUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryControlConfigRoutingControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.RoutingControlCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.RoutingControlDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			// Routing controls created without a control panel are placed in the cluster's default control panel.
+			"control_panel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryControlConfigRoutingControlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	name := d.Get("name").(string)
+	input := &r53rcc.CreateRoutingControlInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		ClusterArn:         aws.String(d.Get("cluster_arn").(string)),
+		RoutingControlName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("control_panel_arn"); ok {
+		input.ControlPanelArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Control Config Routing Control: %s", input)
+	output, err := conn.CreateRoutingControlWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Control Config Routing Control (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RoutingControl.RoutingControlArn))
+
+	if _, err := waiter.RoutingControlCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Routing Control (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigRoutingControlRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigRoutingControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	routingControl, err := finder.RoutingControlByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Control Config Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Control Config Routing Control (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", routingControl.RoutingControlArn)
+	d.Set("control_panel_arn", routingControl.ControlPanelArn)
+	d.Set("name", routingControl.Name)
+	d.Set("status", routingControl.Status)
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryControlConfigRoutingControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	input := &r53rcc.UpdateRoutingControlInput{
+		RoutingControlArn:  aws.String(d.Id()),
+		RoutingControlName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Recovery Control Config Routing Control: %s", input)
+	_, err := conn.UpdateRoutingControlWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Route 53 Recovery Control Config Routing Control (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigRoutingControlRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigRoutingControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Control Config Routing Control: %s", d.Id())
+	_, err := conn.DeleteRoutingControlWithContext(ctx, &r53rcc.DeleteRoutingControlInput{
+		RoutingControlArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Control Config Routing Control (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.RoutingControlDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Routing Control (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
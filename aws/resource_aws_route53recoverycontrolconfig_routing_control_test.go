@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSRoute53RecoveryControlConfigRoutingControl_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_routing_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigRoutingControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigRoutingControlConfigDefaultControlPanel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigRoutingControlExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "route53-recovery-control", regexp.MustCompile(`controlpanel/.+/routingcontrol/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "control_panel_arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// cluster_arn is not returned by the API.
+				ImportStateVerifyIgnore: []string{"cluster_arn"},
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigRoutingControl_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_routing_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigRoutingControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigRoutingControlConfigDefaultControlPanel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigRoutingControlExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53RecoveryControlConfigRoutingControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigRoutingControl_NonDefaultControlPanel(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_routing_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigRoutingControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigRoutingControlConfigNonDefaultControlPanel(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigRoutingControlExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "control_panel_arn", "aws_route53recoverycontrolconfig_control_panel.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// cluster_arn is not returned by the API.
+				ImportStateVerifyIgnore: []string{"cluster_arn"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigRoutingControlDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53recoverycontrolconfig_routing_control" {
+			continue
+		}
+
+		_, err := finder.RoutingControlByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Recovery Control Config Routing Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigRoutingControlExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Recovery Control Config Routing Control ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+		_, err := finder.RoutingControlByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRoute53RecoveryControlConfigRoutingControlConfigDefaultControlPanel(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+`, rName)
+}
+
+func testAccAWSRoute53RecoveryControlConfigRoutingControlConfigNonDefaultControlPanel(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_control_panel" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name              = %[1]q
+  cluster_arn       = aws_route53recoverycontrolconfig_cluster.test.arn
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+}
+`, rName)
+}
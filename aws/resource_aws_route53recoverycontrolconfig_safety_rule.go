@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryControlConfigSafetyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryControlConfigSafetyRuleCreate,
+		ReadContext:   resourceAwsRoute53RecoveryControlConfigSafetyRuleRead,
+		UpdateContext: resourceAwsRoute53RecoveryControlConfigSafetyRuleUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryControlConfigSafetyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.SafetyRuleCreatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.SafetyRuleDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asserted_controls": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+				ExactlyOneOf: []string{"asserted_controls", "gating_controls"},
+			},
+			"control_panel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"gating_controls": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+				ExactlyOneOf: []string{"asserted_controls", "gating_controls"},
+				RequiredWith: []string{"target_controls"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"rule_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inverted": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"threshold": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(r53rcc.RuleType_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_controls": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validateArn},
+				RequiredWith: []string{"gating_controls"},
+			},
+			"wait_period_ms": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryControlConfigSafetyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	name := d.Get("name").(string)
+	input := &r53rcc.CreateSafetyRuleInput{
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("asserted_controls"); ok && len(v.([]interface{})) > 0 {
+		input.AssertionRule = &r53rcc.NewAssertionRule{
+			AssertedControls: expandStringList(v.([]interface{})),
+			ControlPanelArn:  aws.String(d.Get("control_panel_arn").(string)),
+			Name:             aws.String(name),
+			RuleConfig:       expandRoute53RecoveryControlConfigRuleConfig(d.Get("rule_config").([]interface{})[0].(map[string]interface{})),
+			WaitPeriodMs:     aws.Int64(int64(d.Get("wait_period_ms").(int))),
+		}
+	} else {
+		input.GatingRule = &r53rcc.NewGatingRule{
+			ControlPanelArn: aws.String(d.Get("control_panel_arn").(string)),
+			GatingControls:  expandStringList(d.Get("gating_controls").([]interface{})),
+			Name:            aws.String(name),
+			RuleConfig:      expandRoute53RecoveryControlConfigRuleConfig(d.Get("rule_config").([]interface{})[0].(map[string]interface{})),
+			TargetControls:  expandStringList(d.Get("target_controls").([]interface{})),
+			WaitPeriodMs:    aws.Int64(int64(d.Get("wait_period_ms").(int))),
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Control Config Safety Rule: %s", input)
+	output, err := conn.CreateSafetyRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Control Config Safety Rule (%s): %s", name, err)
+	}
+
+	if output.AssertionRule != nil {
+		d.SetId(aws.StringValue(output.AssertionRule.SafetyRuleArn))
+	} else {
+		d.SetId(aws.StringValue(output.GatingRule.SafetyRuleArn))
+	}
+
+	if _, err := waiter.SafetyRuleCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Safety Rule (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigSafetyRuleRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigSafetyRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	output, err := finder.SafetyRuleByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Control Config Safety Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Control Config Safety Rule (%s): %s", d.Id(), err)
+	}
+
+	if rule := output.AssertionRule; rule != nil {
+		d.Set("arn", rule.SafetyRuleArn)
+		d.Set("asserted_controls", aws.StringValueSlice(rule.AssertedControls))
+		d.Set("control_panel_arn", rule.ControlPanelArn)
+		d.Set("gating_controls", nil)
+		d.Set("name", rule.Name)
+		if err := d.Set("rule_config", []interface{}{flattenRoute53RecoveryControlConfigRuleConfig(rule.RuleConfig)}); err != nil {
+			return diag.Errorf("error setting rule_config: %s", err)
+		}
+		d.Set("status", rule.Status)
+		d.Set("target_controls", nil)
+		d.Set("wait_period_ms", rule.WaitPeriodMs)
+	} else {
+		rule := output.GatingRule
+		d.Set("arn", rule.SafetyRuleArn)
+		d.Set("asserted_controls", nil)
+		d.Set("control_panel_arn", rule.ControlPanelArn)
+		d.Set("gating_controls", aws.StringValueSlice(rule.GatingControls))
+		d.Set("name", rule.Name)
+		if err := d.Set("rule_config", []interface{}{flattenRoute53RecoveryControlConfigRuleConfig(rule.RuleConfig)}); err != nil {
+			return diag.Errorf("error setting rule_config: %s", err)
+		}
+		d.Set("status", rule.Status)
+		d.Set("target_controls", aws.StringValueSlice(rule.TargetControls))
+		d.Set("wait_period_ms", rule.WaitPeriodMs)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryControlConfigSafetyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	input := &r53rcc.UpdateSafetyRuleInput{}
+
+	if _, ok := d.GetOk("asserted_controls"); ok {
+		input.AssertionRuleUpdate = &r53rcc.AssertionRuleUpdate{
+			Name:          aws.String(d.Get("name").(string)),
+			SafetyRuleArn: aws.String(d.Id()),
+			WaitPeriodMs:  aws.Int64(int64(d.Get("wait_period_ms").(int))),
+		}
+	} else {
+		input.GatingRuleUpdate = &r53rcc.GatingRuleUpdate{
+			Name:          aws.String(d.Get("name").(string)),
+			SafetyRuleArn: aws.String(d.Id()),
+			WaitPeriodMs:  aws.Int64(int64(d.Get("wait_period_ms").(int))),
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Recovery Control Config Safety Rule: %s", input)
+	_, err := conn.UpdateSafetyRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Route 53 Recovery Control Config Safety Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53RecoveryControlConfigSafetyRuleRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryControlConfigSafetyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Control Config Safety Rule: %s", d.Id())
+	_, err := conn.DeleteSafetyRuleWithContext(ctx, &r53rcc.DeleteSafetyRuleInput{
+		SafetyRuleArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Control Config Safety Rule (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.SafetyRuleDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Route 53 Recovery Control Config Safety Rule (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRoute53RecoveryControlConfigRuleConfig(tfMap map[string]interface{}) *r53rcc.RuleConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &r53rcc.RuleConfig{}
+
+	if v, ok := tfMap["inverted"].(bool); ok {
+		apiObject.Inverted = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["threshold"].(int); ok {
+		apiObject.Threshold = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRoute53RecoveryControlConfigRuleConfig(apiObject *r53rcc.RuleConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Inverted; v != nil {
+		tfMap["inverted"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Threshold; v != nil {
+		tfMap["threshold"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
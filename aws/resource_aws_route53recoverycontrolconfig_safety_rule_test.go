@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoverycontrolconfig/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRule_AssertionRule(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_safety_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigAssertionRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "route53-recovery-control", regexp.MustCompile(`controlpanel/.+/safetyrule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asserted_controls.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "asserted_controls.0", "aws_route53recoverycontrolconfig_routing_control.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "control_panel_arn", "aws_route53recoverycontrolconfig_control_panel.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "gating_controls.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_config.0.inverted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_config.0.threshold", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_config.0.type", r53rcc.RuleTypeAtleast),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+					resource.TestCheckResourceAttr(resourceName, "target_controls.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_period_ms", "5000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRule_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_safety_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigAssertionRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53RecoveryControlConfigSafetyRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRule_GatingRule(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53recoverycontrolconfig_safety_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53RecoveryControlConfig(t) },
+		ErrorCheck:   testAccErrorCheck(t, r53rcc.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigGatingRule(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asserted_controls.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "gating_controls.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "gating_controls.0", "aws_route53recoverycontrolconfig_routing_control.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_config.0.type", r53rcc.RuleTypeOr),
+					resource.TestCheckResourceAttr(resourceName, "status", r53rcc.StatusDeployed),
+					resource.TestCheckResourceAttr(resourceName, "target_controls.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_controls.0", "aws_route53recoverycontrolconfig_routing_control.test2", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "wait_period_ms", "5000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53recoverycontrolconfig_safety_rule" {
+			continue
+		}
+
+		_, err := finder.SafetyRuleByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Recovery Control Config Safety Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53RecoveryControlConfigSafetyRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Recovery Control Config Safety Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+		_, err := finder.SafetyRuleByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_control_panel" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name              = "%[1]s-1"
+  cluster_arn       = aws_route53recoverycontrolconfig_cluster.test.arn
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+}
+`, rName)
+}
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigAssertionRule(rName string) string {
+	return composeConfig(testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
+  asserted_controls = [aws_route53recoverycontrolconfig_routing_control.test.arn]
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+  name              = %[1]q
+  wait_period_ms    = 5000
+
+  rule_config {
+    inverted  = false
+    threshold = 1
+    type      = "ATLEAST"
+  }
+}
+`, rName))
+}
+
+func testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigGatingRule(rName string) string {
+	return composeConfig(testAccAWSRoute53RecoveryControlConfigSafetyRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_routing_control" "test2" {
+  name              = "%[1]s-2"
+  cluster_arn       = aws_route53recoverycontrolconfig_cluster.test.arn
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+}
+
+resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+  gating_controls   = [aws_route53recoverycontrolconfig_routing_control.test.arn]
+  name              = %[1]q
+  target_controls   = [aws_route53recoverycontrolconfig_routing_control.test2.arn]
+  wait_period_ms    = 5000
+
+  rule_config {
+    inverted  = false
+    threshold = 0
+    type      = "OR"
+  }
+}
+`, rName))
+}
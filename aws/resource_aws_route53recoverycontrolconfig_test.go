@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+)
+
+// Route 53 Recovery Control Config clusters are costly and have a low
+// per-account quota, so run serially locally and in TeamCity.
+func TestAccAWSRoute53RecoveryControlConfig_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Cluster": {
+			"basic":      testAccAWSRoute53RecoveryControlConfigCluster_basic,
+			"disappears": testAccAWSRoute53RecoveryControlConfigCluster_disappears,
+		},
+		"ControlPanel": {
+			"basic":      testAccAWSRoute53RecoveryControlConfigControlPanel_basic,
+			"disappears": testAccAWSRoute53RecoveryControlConfigControlPanel_disappears,
+			"Name":       testAccAWSRoute53RecoveryControlConfigControlPanel_Name,
+		},
+		"RoutingControl": {
+			"basic":                  testAccAWSRoute53RecoveryControlConfigRoutingControl_basic,
+			"disappears":             testAccAWSRoute53RecoveryControlConfigRoutingControl_disappears,
+			"NonDefaultControlPanel": testAccAWSRoute53RecoveryControlConfigRoutingControl_NonDefaultControlPanel,
+		},
+		"SafetyRule": {
+			"AssertionRule": testAccAWSRoute53RecoveryControlConfigSafetyRule_AssertionRule,
+			"disappears":    testAccAWSRoute53RecoveryControlConfigSafetyRule_disappears,
+			"GatingRule":    testAccAWSRoute53RecoveryControlConfigSafetyRule_GatingRule,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheckAWSRoute53RecoveryControlConfig(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+
+	input := &r53rcc.ListClustersInput{}
+
+	_, err := conn.ListClusters(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
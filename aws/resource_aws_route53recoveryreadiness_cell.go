@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rr "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoveryreadiness/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryReadinessCell() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryReadinessCellCreate,
+		ReadContext:   resourceAwsRoute53RecoveryReadinessCellRead,
+		UpdateContext: resourceAwsRoute53RecoveryReadinessCellUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryReadinessCellDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cell_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"cells": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_readiness_scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryReadinessCellCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("cell_name").(string)
+	input := &r53rr.CreateCellInput{
+		CellName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("cells"); ok && len(v.([]interface{})) > 0 {
+		input.Cells = expandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Route53recoveryreadinessTags()
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Readiness Cell: %s", input)
+	output, err := conn.CreateCellWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Readiness Cell (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CellName))
+
+	return resourceAwsRoute53RecoveryReadinessCellRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessCellRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	cell, err := finder.CellByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Readiness Cell (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Readiness Cell (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(cell.CellArn)
+	d.Set("arn", arn)
+	d.Set("cell_name", cell.CellName)
+	d.Set("cells", aws.StringValueSlice(cell.Cells))
+	d.Set("parent_readiness_scopes", aws.StringValueSlice(cell.ParentReadinessScopes))
+
+	tags, err := keyvaluetags.Route53recoveryreadinessListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Recovery Readiness Cell (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryReadinessCellUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	if d.HasChange("cells") {
+		input := &r53rr.UpdateCellInput{
+			CellName: aws.String(d.Id()),
+			Cells:    expandStringList(d.Get("cells").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Recovery Readiness Cell: %s", input)
+		_, err := conn.UpdateCellWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Cell (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53recoveryreadinessUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Cell (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53RecoveryReadinessCellRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessCellDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Readiness Cell: %s", d.Id())
+	_, err := conn.DeleteCellWithContext(ctx, &r53rr.DeleteCellInput{
+		CellName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Readiness Cell (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rr "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoveryreadiness/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryReadinessReadinessCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryReadinessReadinessCheckCreate,
+		ReadContext:   resourceAwsRoute53RecoveryReadinessReadinessCheckRead,
+		UpdateContext: resourceAwsRoute53RecoveryReadinessReadinessCheckUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryReadinessReadinessCheckDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"readiness_check_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"resource_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryReadinessReadinessCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("readiness_check_name").(string)
+	input := &r53rr.CreateReadinessCheckInput{
+		ReadinessCheckName: aws.String(name),
+		ResourceSetName:    aws.String(d.Get("resource_set_name").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Route53recoveryreadinessTags()
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Readiness Readiness Check: %s", input)
+	output, err := conn.CreateReadinessCheckWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Readiness Readiness Check (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReadinessCheckName))
+
+	return resourceAwsRoute53RecoveryReadinessReadinessCheckRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessReadinessCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	readinessCheck, err := finder.ReadinessCheckByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Readiness Readiness Check (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Readiness Readiness Check (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(readinessCheck.ReadinessCheckArn)
+	d.Set("arn", arn)
+	d.Set("readiness_check_name", readinessCheck.ReadinessCheckName)
+	d.Set("resource_set_name", readinessCheck.ResourceSet)
+
+	tags, err := keyvaluetags.Route53recoveryreadinessListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Recovery Readiness Readiness Check (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryReadinessReadinessCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	if d.HasChange("resource_set_name") {
+		input := &r53rr.UpdateReadinessCheckInput{
+			ReadinessCheckName: aws.String(d.Id()),
+			ResourceSetName:    aws.String(d.Get("resource_set_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Recovery Readiness Readiness Check: %s", input)
+		_, err := conn.UpdateReadinessCheckWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Readiness Check (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53recoveryreadinessUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Readiness Check (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53RecoveryReadinessReadinessCheckRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessReadinessCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Readiness Readiness Check: %s", d.Id())
+	_, err := conn.DeleteReadinessCheckWithContext(ctx, &r53rr.DeleteReadinessCheckInput{
+		ReadinessCheckName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Readiness Readiness Check (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rr "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoveryreadiness/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryReadinessRecoveryGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryReadinessRecoveryGroupCreate,
+		ReadContext:   resourceAwsRoute53RecoveryReadinessRecoveryGroupRead,
+		UpdateContext: resourceAwsRoute53RecoveryReadinessRecoveryGroupUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryReadinessRecoveryGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recovery_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"cells": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryReadinessRecoveryGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("recovery_group_name").(string)
+	input := &r53rr.CreateRecoveryGroupInput{
+		RecoveryGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("cells"); ok && len(v.([]interface{})) > 0 {
+		input.Cells = expandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Route53recoveryreadinessTags()
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Readiness Recovery Group: %s", input)
+	output, err := conn.CreateRecoveryGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Readiness Recovery Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RecoveryGroupName))
+
+	return resourceAwsRoute53RecoveryReadinessRecoveryGroupRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessRecoveryGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	recoveryGroup, err := finder.RecoveryGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Readiness Recovery Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Readiness Recovery Group (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(recoveryGroup.RecoveryGroupArn)
+	d.Set("arn", arn)
+	d.Set("recovery_group_name", recoveryGroup.RecoveryGroupName)
+	d.Set("cells", aws.StringValueSlice(recoveryGroup.Cells))
+
+	tags, err := keyvaluetags.Route53recoveryreadinessListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Recovery Readiness Recovery Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryReadinessRecoveryGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	if d.HasChange("cells") {
+		input := &r53rr.UpdateRecoveryGroupInput{
+			RecoveryGroupName: aws.String(d.Id()),
+			Cells:             expandStringList(d.Get("cells").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Recovery Readiness Recovery Group: %s", input)
+		_, err := conn.UpdateRecoveryGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Recovery Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53recoveryreadinessUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Recovery Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53RecoveryReadinessRecoveryGroupRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessRecoveryGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Readiness Recovery Group: %s", d.Id())
+	_, err := conn.DeleteRecoveryGroupWithContext(ctx, &r53rr.DeleteRecoveryGroupInput{
+		RecoveryGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Readiness Recovery Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,465 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rr "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53recoveryreadiness/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsRoute53RecoveryReadinessResourceSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRoute53RecoveryReadinessResourceSetCreate,
+		ReadContext:   resourceAwsRoute53RecoveryReadinessResourceSetRead,
+		UpdateContext: resourceAwsRoute53RecoveryReadinessResourceSetUpdate,
+		DeleteContext: resourceAwsRoute53RecoveryReadinessResourceSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"resource_set_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^AWS::[A-Za-z0-9]+::[A-Za-z0-9]+$`),
+					"must be an AWS CloudFormation resource type, e.g. AWS::ElasticLoadBalancingV2::LoadBalancer",
+				),
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_target_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"hosted_zone_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"record_set_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"record_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"target_resource": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"nlb_resource": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"arn": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+												"r53_resource": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"domain_name": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"record_set_id": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"readiness_scopes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsRoute53RecoveryReadinessResourceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("resource_set_name").(string)
+	input := &r53rr.CreateResourceSetInput{
+		ResourceSetName: aws.String(name),
+		ResourceSetType: aws.String(d.Get("resource_set_type").(string)),
+		Resources:       expandRoute53RecoveryReadinessResources(d.Get("resources").([]interface{})),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Route53recoveryreadinessTags()
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Recovery Readiness Resource Set: %s", input)
+	output, err := conn.CreateResourceSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 Recovery Readiness Resource Set (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceSetName))
+
+	return resourceAwsRoute53RecoveryReadinessResourceSetRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessResourceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resourceSet, err := finder.ResourceSetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Recovery Readiness Resource Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Recovery Readiness Resource Set (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(resourceSet.ResourceSetArn)
+	d.Set("arn", arn)
+	d.Set("resource_set_name", resourceSet.ResourceSetName)
+	d.Set("resource_set_type", resourceSet.ResourceSetType)
+	if err := d.Set("resources", flattenRoute53RecoveryReadinessResources(resourceSet.Resources)); err != nil {
+		return diag.Errorf("error setting resources: %s", err)
+	}
+
+	tags, err := keyvaluetags.Route53recoveryreadinessListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Recovery Readiness Resource Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53RecoveryReadinessResourceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	if d.HasChange("resources") {
+		input := &r53rr.UpdateResourceSetInput{
+			ResourceSetName: aws.String(d.Id()),
+			ResourceSetType: aws.String(d.Get("resource_set_type").(string)),
+			Resources:       expandRoute53RecoveryReadinessResources(d.Get("resources").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Recovery Readiness Resource Set: %s", input)
+		_, err := conn.UpdateResourceSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Resource Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53recoveryreadinessUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Route 53 Recovery Readiness Resource Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53RecoveryReadinessResourceSetRead(ctx, d, meta)
+}
+
+func resourceAwsRoute53RecoveryReadinessResourceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).route53recoveryreadinessconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Recovery Readiness Resource Set: %s", d.Id())
+	_, err := conn.DeleteResourceSetWithContext(ctx, &r53rr.DeleteResourceSetInput{
+		ResourceSetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rr.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 Recovery Readiness Resource Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRoute53RecoveryReadinessResource(tfMap map[string]interface{}) *r53rr.Resource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &r53rr.Resource{}
+
+	if v, ok := tfMap["dns_target_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DnsTargetResource = expandRoute53RecoveryReadinessDNSTargetResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["readiness_scopes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ReadinessScopes = expandStringList(v)
+	}
+
+	if v, ok := tfMap["resource_arn"].(string); ok && v != "" {
+		apiObject.ResourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoute53RecoveryReadinessResources(tfList []interface{}) []*r53rr.Resource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*r53rr.Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandRoute53RecoveryReadinessResource(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandRoute53RecoveryReadinessDNSTargetResource(tfMap map[string]interface{}) *r53rr.DNSTargetResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &r53rr.DNSTargetResource{}
+
+	if v, ok := tfMap["domain_name"].(string); ok && v != "" {
+		apiObject.DomainName = aws.String(v)
+	}
+
+	if v, ok := tfMap["hosted_zone_arn"].(string); ok && v != "" {
+		apiObject.HostedZoneArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["record_set_id"].(string); ok && v != "" {
+		apiObject.RecordSetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["record_type"].(string); ok && v != "" {
+		apiObject.RecordType = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TargetResource = expandRoute53RecoveryReadinessTargetResource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandRoute53RecoveryReadinessTargetResource(tfMap map[string]interface{}) *r53rr.TargetResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &r53rr.TargetResource{}
+
+	if v, ok := tfMap["nlb_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NLBResource = &r53rr.NLBResource{}
+
+		if v, ok := v[0].(map[string]interface{})["arn"].(string); ok && v != "" {
+			apiObject.NLBResource.Arn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["r53_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.R53Resource = &r53rr.R53ResourceRecord{}
+
+		if v, ok := tfMap["domain_name"].(string); ok && v != "" {
+			apiObject.R53Resource.DomainName = aws.String(v)
+		}
+
+		if v, ok := tfMap["record_set_id"].(string); ok && v != "" {
+			apiObject.R53Resource.RecordSetId = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRoute53RecoveryReadinessResource(apiObject *r53rr.Resource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ComponentId; v != nil {
+		tfMap["component_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DnsTargetResource; v != nil {
+		tfMap["dns_target_resource"] = []interface{}{flattenRoute53RecoveryReadinessDNSTargetResource(v)}
+	}
+
+	if v := apiObject.ReadinessScopes; v != nil {
+		tfMap["readiness_scopes"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ResourceArn; v != nil {
+		tfMap["resource_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53RecoveryReadinessResources(apiObjects []*r53rr.Resource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenRoute53RecoveryReadinessResource(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenRoute53RecoveryReadinessDNSTargetResource(apiObject *r53rr.DNSTargetResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DomainName; v != nil {
+		tfMap["domain_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HostedZoneArn; v != nil {
+		tfMap["hosted_zone_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RecordSetId; v != nil {
+		tfMap["record_set_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RecordType; v != nil {
+		tfMap["record_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TargetResource; v != nil {
+		tfMap["target_resource"] = []interface{}{flattenRoute53RecoveryReadinessTargetResource(v)}
+	}
+
+	return tfMap
+}
+
+func flattenRoute53RecoveryReadinessTargetResource(apiObject *r53rr.TargetResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NLBResource; v != nil {
+		tfMap["nlb_resource"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	if v := apiObject.R53Resource; v != nil {
+		tfMap["r53_resource"] = []interface{}{map[string]interface{}{
+			"domain_name":   aws.StringValue(v.DomainName),
+			"record_set_id": aws.StringValue(v.RecordSetId),
+		}}
+	}
+
+	return tfMap
+}
@@ -14,8 +14,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -28,6 +30,9 @@ import (
 
 const s3BucketObjectCreationTimeout = 2 * time.Minute
 
+// s3ObjectMaxPutObjectSize is the largest object that can be uploaded with a single PutObject request.
+const s3ObjectMaxPutObjectSize = 5 * 1024 * 1024 * 1024
+
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectCreate,
@@ -153,8 +158,9 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and with multipart uploads,
+				// which are used when upload_part_size is set or the object is too large for PutObject.
+				// The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -202,6 +208,18 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 		},
 	}
 }
@@ -212,6 +230,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	var body io.ReadSeeker
+	var size int64
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -224,7 +243,13 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", path, err)
 		}
 
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", path, err)
+		}
+
 		body = file
+		size = info.Size()
 		defer func() {
 			err := file.Close()
 			if err != nil {
@@ -234,6 +259,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
+		size = int64(len(content))
 	} else if v, ok := d.GetOk("content_base64"); ok {
 		content := v.(string)
 		// We can't do streaming decoding here (with base64.NewDecoder) because
@@ -243,6 +269,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("error decoding content_base64: %s", err)
 		}
 		body = bytes.NewReader(contentRaw)
+		size = int64(len(contentRaw))
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -317,7 +344,28 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := s3conn.PutObject(putInput); err != nil {
+	// A single PutObject request keeps the ETag equal to the MD5 of the content.
+	// The uploader is only used when a part size is configured or the content is
+	// too large for PutObject, as it sends bodies larger than the part size as a
+	// multipart upload.
+	if _, ok := d.GetOk("upload_part_size"); ok || size > s3ObjectMaxPutObjectSize {
+		uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+			if v, ok := d.GetOk("upload_concurrency"); ok {
+				u.Concurrency = v.(int)
+			}
+
+			if v, ok := d.GetOk("upload_part_size"); ok {
+				u.PartSize = int64(v.(int))
+			}
+		})
+
+		uploadInput := &s3manager.UploadInput{}
+		awsutil.Copy(uploadInput, putInput)
+
+		if _, err := uploader.Upload(uploadInput); err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+	} else if _, err := s3conn.PutObject(putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
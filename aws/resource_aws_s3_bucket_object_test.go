@@ -189,6 +189,52 @@ func TestAccAWSS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_multipartUpload(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// 11 MiB is uploaded as three 5 MiB parts, the last one partial.
+	content := strings.Repeat("0123456789abcdef", 11*1024*1024/16)
+	source := testAccAWSS3BucketObjectCreateTempFile(t, content)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigMultipartUpload(rName, source, 5*1024*1024, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, content),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "upload_concurrency", "upload_part_size"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				// Changing the upload settings alone does not upload the object again.
+				Config: testAccAWSS3BucketObjectConfigMultipartUpload(rName, source, 16*1024*1024, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "16777216"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1675,6 +1721,23 @@ resource "aws_s3_bucket_object" "object" {
 `, rName, source)
 }
 
+func testAccAWSS3BucketObjectConfigMultipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  source_hash        = filemd5(%[2]q)
+  upload_part_size   = %[3]d
+  upload_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccAWSS3BucketObjectConfig_withContentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,795 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationCreate,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationResourceUpdate,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_marker_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.DeleteMarkerReplicationStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_translation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"owner": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.OwnerOverride_Values(), false),
+												},
+											},
+										},
+									},
+									"account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"replica_kms_key_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event_threshold": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(0),
+															},
+														},
+													},
+												},
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.MetricsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"replication_time": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicationTimeStatus_Values(), false),
+												},
+												"time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(0),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"existing_object_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ExistingObjectReplicationStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+												"tags": tagsSchema(),
+											},
+										},
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"source_selection_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"replica_modifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicaModificationsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"sse_kms_encrypted_objects": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.SseKmsEncryptedObjectsStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ReplicationRuleStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandS3BucketReplicationConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAwsErrCodeEquals(waiter.PropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketReplication(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	d.SetId(tfs3.CreateResourceID(bucket, expectedBucketOwner))
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, expectedBucketOwner, err := tfs3.ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	// Retry for eventual consistency on creation
+	var output *s3.GetBucketReplicationOutput
+	err = resource.Retry(waiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.GetBucketReplication(input)
+
+		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfs3.ErrCodeReplicationConfigurationNotFound) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.GetBucketReplication(input)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, tfs3.ErrCodeReplicationConfigurationNotFound) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("expected_bucket_owner", expectedBucketOwner)
+	d.Set("role", output.ReplicationConfiguration.Role)
+
+	if err := d.Set("rule", flattenS3BucketReplicationConfigurationRules(output.ReplicationConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, expectedBucketOwner, err := tfs3.ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandS3BucketReplicationConfigurationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = conn.PutBucketReplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, expectedBucketOwner, err := tfs3.ParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err = conn.DeleteBucketReplication(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3BucketReplicationConfigurationRules(tfList []interface{}) []*s3.ReplicationRule {
+	var apiObjects []*s3.ReplicationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.ReplicationRule{}
+
+		if v, ok := tfMap["delete_marker_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+				Status: aws.String(tfMap["status"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Destination = expandS3BucketReplicationConfigurationDestination(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["existing_object_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ExistingObjectReplication = &s3.ExistingObjectReplication{
+				Status: aws.String(tfMap["status"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+			// An empty filter block selects every object using the V2 replication schema.
+			filter := &s3.ReplicationRuleFilter{}
+
+			if v[0] != nil {
+				filter = expandS3BucketReplicationConfigurationFilter(v[0].(map[string]interface{}))
+			}
+
+			apiObject.Filter = filter
+
+			if v, ok := tfMap["priority"].(int); ok {
+				apiObject.Priority = aws.Int64(int64(v))
+			}
+		} else if v, ok := tfMap["prefix"].(string); ok {
+			// Rules without a filter use the V1 replication schema.
+			apiObject.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_selection_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceSelectionCriteria = expandS3BucketReplicationConfigurationSourceSelectionCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3BucketReplicationConfigurationDestination(tfMap map[string]interface{}) *s3.Destination {
+	apiObject := &s3.Destination{}
+
+	if v, ok := tfMap["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AccessControlTranslation = &s3.AccessControlTranslation{
+			Owner: aws.String(tfMap["owner"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["account"].(string); ok && v != "" {
+		apiObject.Account = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EncryptionConfiguration = &s3.EncryptionConfiguration{
+			ReplicaKmsKeyID: aws.String(tfMap["replica_kms_key_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metrics := &s3.Metrics{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["event_threshold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			metrics.EventThreshold = &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+			}
+		}
+
+		apiObject.Metrics = metrics
+	}
+
+	if v, ok := tfMap["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		replicationTime := &s3.ReplicationTime{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			replicationTime.Time = &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+			}
+		}
+
+		apiObject.ReplicationTime = replicationTime
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3BucketReplicationConfigurationFilter(tfMap map[string]interface{}) *s3.ReplicationRuleFilter {
+	apiObject := &s3.ReplicationRuleFilter{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		and := &s3.ReplicationRuleAndOperator{}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			and.Prefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			and.Tags = keyvaluetags.New(v).IgnoreAws().S3Tags()
+		}
+
+		apiObject.And = and
+	} else if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Tag = &s3.Tag{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	} else if v, ok := tfMap["prefix"].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3BucketReplicationConfigurationSourceSelectionCriteria(tfMap map[string]interface{}) *s3.SourceSelectionCriteria {
+	apiObject := &s3.SourceSelectionCriteria{}
+
+	if v, ok := tfMap["replica_modifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ReplicaModifications = &s3.ReplicaModifications{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sse_kms_encrypted_objects"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SseKmsEncryptedObjects = &s3.SseKmsEncryptedObjects{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenS3BucketReplicationConfigurationRules(apiObjects []*s3.ReplicationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":       aws.StringValue(apiObject.ID),
+			"prefix":   aws.StringValue(apiObject.Prefix),
+			"priority": int(aws.Int64Value(apiObject.Priority)),
+			"status":   aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.DeleteMarkerReplication; v != nil {
+			tfMap["delete_marker_replication"] = []interface{}{
+				map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				},
+			}
+		}
+
+		if v := apiObject.Destination; v != nil {
+			tfMap["destination"] = []interface{}{flattenS3BucketReplicationConfigurationDestination(v)}
+		}
+
+		if v := apiObject.ExistingObjectReplication; v != nil {
+			tfMap["existing_object_replication"] = []interface{}{
+				map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				},
+			}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{flattenS3BucketReplicationConfigurationFilter(v)}
+		}
+
+		if v := apiObject.SourceSelectionCriteria; v != nil {
+			tfMap["source_selection_criteria"] = []interface{}{flattenS3BucketReplicationConfigurationSourceSelectionCriteria(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenS3BucketReplicationConfigurationDestination(apiObject *s3.Destination) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"account":       aws.StringValue(apiObject.Account),
+		"bucket":        aws.StringValue(apiObject.Bucket),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.AccessControlTranslation; v != nil {
+		tfMap["access_control_translation"] = []interface{}{
+			map[string]interface{}{
+				"owner": aws.StringValue(v.Owner),
+			},
+		}
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{
+			map[string]interface{}{
+				"replica_kms_key_id": aws.StringValue(v.ReplicaKmsKeyID),
+			},
+		}
+	}
+
+	if v := apiObject.Metrics; v != nil {
+		metrics := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v := v.EventThreshold; v != nil {
+			metrics["event_threshold"] = []interface{}{
+				map[string]interface{}{
+					"minutes": int(aws.Int64Value(v.Minutes)),
+				},
+			}
+		}
+
+		tfMap["metrics"] = []interface{}{metrics}
+	}
+
+	if v := apiObject.ReplicationTime; v != nil {
+		replicationTime := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v := v.Time; v != nil {
+			replicationTime["time"] = []interface{}{
+				map[string]interface{}{
+					"minutes": int(aws.Int64Value(v.Minutes)),
+				},
+			}
+		}
+
+		tfMap["replication_time"] = []interface{}{replicationTime}
+	}
+
+	return tfMap
+}
+
+func flattenS3BucketReplicationConfigurationFilter(apiObject *s3.ReplicationRuleFilter) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"prefix": aws.StringValue(apiObject.Prefix),
+	}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"prefix": aws.StringValue(v.Prefix),
+				"tags":   keyvaluetags.S3KeyValueTags(v.Tags).IgnoreAws().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return tfMap
+}
+
+func flattenS3BucketReplicationConfigurationSourceSelectionCriteria(apiObject *s3.SourceSelectionCriteria) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReplicaModifications; v != nil {
+		tfMap["replica_modifications"] = []interface{}{
+			map[string]interface{}{
+				"status": aws.StringValue(v.Status),
+			},
+		}
+	}
+
+	if v := apiObject.SseKmsEncryptedObjects; v != nil {
+		tfMap["sse_kms_encrypted_objects"] = []interface{}{
+			map[string]interface{}{
+				"status": aws.StringValue(v.Status),
+			},
+		}
+	}
+
+	return tfMap
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -9,13 +10,26 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+const (
+	// The largest source object that a single CopyObject request accepts.
+	s3ObjectCopyMaxSingleCopySize = 5 * 1024 * 1024 * 1024
+
+	// The part size used when copying larger objects with UploadPartCopy.
+	s3ObjectCopyMultipartPartSize = 512 * 1024 * 1024
+)
+
 func resourceAwsS3ObjectCopy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3ObjectCopyCreate,
@@ -256,6 +270,10 @@ func resourceAwsS3ObjectCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"source_version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -284,7 +302,10 @@ func resourceAwsS3ObjectCopy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsS3ObjectCopyCustomizeDiff,
+			SetTagsDiff,
+		),
 	}
 }
 
@@ -432,6 +453,7 @@ func resourceAwsS3ObjectCopyUpdate(d *schema.ResourceData, meta interface{}) err
 		"source_customer_algorithm",
 		"source_customer_key",
 		"source_customer_key_md5",
+		"source_etag",
 		"storage_class",
 		"tagging_directive",
 		"tags",
@@ -622,6 +644,24 @@ func resourceAwsS3ObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) err
 		input.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
+	sourceConn, sourceInput, err := expandS3ObjectCopySourceHeadObjectInput(conn, d)
+
+	if err != nil {
+		return err
+	}
+
+	source, err := sourceConn.HeadObject(sourceInput)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 object copy source (%s): %w", d.Get("source").(string), err)
+	}
+
+	d.Set("source_etag", strings.Trim(aws.StringValue(source.ETag), `"`))
+
+	if aws.Int64Value(source.ContentLength) > s3ObjectCopyMaxSingleCopySize {
+		return resourceAwsS3ObjectCopyDoMultipartCopy(d, meta, input, sourceConn, sourceInput, source)
+	}
+
 	output, err := conn.CopyObject(input)
 	if err != nil {
 		return fmt.Errorf("error copying S3 object (bucket: %s; key: %s; source: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), aws.StringValue(input.CopySource), err)
@@ -647,6 +687,324 @@ func resourceAwsS3ObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) err
 	return resourceAwsS3BucketObjectRead(d, meta)
 }
 
+func resourceAwsS3ObjectCopyDoMultipartCopy(d *schema.ResourceData, meta interface{}, input *s3.CopyObjectInput, sourceConn *s3.S3, sourceInput *s3.HeadObjectInput, source *s3.HeadObjectOutput) error {
+	conn := meta.(*AWSClient).s3conn
+
+	createInput := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		ExpectedBucketOwner:       input.ExpectedBucketOwner,
+		Expires:                   input.Expires,
+		GrantFullControl:          input.GrantFullControl,
+		GrantRead:                 input.GrantRead,
+		GrantReadACP:              input.GrantReadACP,
+		GrantWriteACP:             input.GrantWriteACP,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		RequestPayer:              input.RequestPayer,
+		SSECustomerAlgorithm:      input.SSECustomerAlgorithm,
+		SSECustomerKey:            input.SSECustomerKey,
+		SSECustomerKeyMD5:         input.SSECustomerKeyMD5,
+		SSEKMSEncryptionContext:   input.SSEKMSEncryptionContext,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	// Unlike CopyObject, a multipart upload does not copy the source object's metadata or tags,
+	// so honor the default COPY directives here.
+	if aws.StringValue(input.MetadataDirective) != s3.MetadataDirectiveReplace {
+		createInput.CacheControl = source.CacheControl
+		createInput.ContentDisposition = source.ContentDisposition
+		createInput.ContentEncoding = source.ContentEncoding
+		createInput.ContentLanguage = source.ContentLanguage
+		createInput.ContentType = source.ContentType
+		createInput.Expires = nil
+		createInput.Metadata = source.Metadata
+
+		if v, err := http.ParseTime(aws.StringValue(source.Expires)); err == nil {
+			createInput.Expires = aws.Time(v)
+		}
+	}
+
+	if aws.StringValue(input.TaggingDirective) != s3.TaggingDirectiveReplace {
+		output, err := sourceConn.GetObjectTagging(&s3.GetObjectTaggingInput{
+			Bucket:              sourceInput.Bucket,
+			ExpectedBucketOwner: sourceInput.ExpectedBucketOwner,
+			Key:                 sourceInput.Key,
+			VersionId:           source.VersionId,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing tags for S3 object copy source (%s): %w", d.Get("source").(string), err)
+		}
+
+		createInput.Tagging = nil
+
+		if tags := keyvaluetags.S3KeyValueTags(output.TagSet).IgnoreAws(); len(tags) > 0 {
+			createInput.Tagging = aws.String(tags.UrlEncode())
+		}
+	}
+
+	createOutput, err := conn.CreateMultipartUpload(createInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 multipart upload (bucket: %s; key: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), err)
+	}
+
+	uploadID := createOutput.UploadId
+
+	// Pin the source version so that every part is copied from the same object.
+	copySource := aws.StringValue(input.CopySource)
+	if v := aws.StringValue(source.VersionId); v != "" && v != "null" {
+		copySource = fmt.Sprintf("%s?versionId=%s", copySource, url.QueryEscape(v))
+	}
+
+	size := aws.Int64Value(source.ContentLength)
+	partSize := int64(s3ObjectCopyMultipartPartSize)
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = size/s3manager.MaxUploadParts + 1
+	}
+
+	var parts []*s3.CompletedPart
+	var sourceVersionID *string
+
+	for partNumber, start := int64(1), int64(0); start < size; partNumber, start = partNumber+1, start+partSize {
+		end := start + partSize - 1
+		if end >= size {
+			end = size - 1
+		}
+
+		partOutput, err := conn.UploadPartCopy(&s3.UploadPartCopyInput{
+			Bucket:                         input.Bucket,
+			CopySource:                     aws.String(copySource),
+			CopySourceIfMatch:              input.CopySourceIfMatch,
+			CopySourceIfModifiedSince:      input.CopySourceIfModifiedSince,
+			CopySourceIfNoneMatch:          input.CopySourceIfNoneMatch,
+			CopySourceIfUnmodifiedSince:    input.CopySourceIfUnmodifiedSince,
+			CopySourceRange:                aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			CopySourceSSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+			CopySourceSSECustomerKey:       input.CopySourceSSECustomerKey,
+			CopySourceSSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+			ExpectedBucketOwner:            input.ExpectedBucketOwner,
+			ExpectedSourceBucketOwner:      input.ExpectedSourceBucketOwner,
+			Key:                            input.Key,
+			PartNumber:                     aws.Int64(partNumber),
+			RequestPayer:                   input.RequestPayer,
+			SSECustomerAlgorithm:           input.SSECustomerAlgorithm,
+			SSECustomerKey:                 input.SSECustomerKey,
+			SSECustomerKeyMD5:              input.SSECustomerKeyMD5,
+			UploadId:                       uploadID,
+		})
+
+		if err != nil {
+			abortS3MultipartUpload(conn, input.Bucket, input.Key, uploadID)
+			return fmt.Errorf("error copying S3 object part %d (bucket: %s; key: %s; source: %s): %w", partNumber, aws.StringValue(input.Bucket), aws.StringValue(input.Key), copySource, err)
+		}
+
+		sourceVersionID = partOutput.CopySourceVersionId
+
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       partOutput.CopyPartResult.ETag,
+			PartNumber: aws.Int64(partNumber),
+		})
+	}
+
+	output, err := conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		RequestPayer: input.RequestPayer,
+		UploadId:     uploadID,
+	})
+
+	if err != nil {
+		abortS3MultipartUpload(conn, input.Bucket, input.Key, uploadID)
+		return fmt.Errorf("error completing S3 multipart upload (bucket: %s; key: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), err)
+	}
+
+	d.Set("customer_algorithm", createOutput.SSECustomerAlgorithm)
+	d.Set("customer_key_md5", createOutput.SSECustomerKeyMD5)
+	d.Set("etag", strings.Trim(aws.StringValue(output.ETag), `"`))
+	d.Set("expiration", output.Expiration)
+	d.Set("kms_encryption_context", createOutput.SSEKMSEncryptionContext)
+	d.Set("kms_key_id", output.SSEKMSKeyId)
+	d.Set("request_charged", output.RequestCharged)
+	d.Set("server_side_encryption", output.ServerSideEncryption)
+	d.Set("source_version_id", sourceVersionID)
+	d.Set("version_id", output.VersionId)
+
+	d.SetId(d.Get("key").(string))
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Copy the object again if the source object has changed since it was last copied.
+	if d.Id() == "" || d.HasChange("source") {
+		return nil
+	}
+
+	sourceETag := d.Get("source_etag").(string)
+
+	if sourceETag == "" {
+		return nil
+	}
+
+	conn, input, err := expandS3ObjectCopySourceHeadObjectInput(meta.(*AWSClient).s3conn, d)
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.HeadObject(input)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 object copy source (%s), skipping change detection: %s", d.Get("source").(string), err)
+		return nil
+	}
+
+	if v := strings.Trim(aws.StringValue(output.ETag), `"`); v != sourceETag {
+		if err := d.SetNew("source_etag", v); err != nil {
+			return fmt.Errorf("error setting source_etag: %w", err)
+		}
+
+		for _, key := range []string{"etag", "last_modified", "source_version_id", "version_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return fmt.Errorf("error setting %s to computed: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// s3ObjectCopyResourceData is implemented by both *schema.ResourceData and *schema.ResourceDiff.
+type s3ObjectCopyResourceData interface {
+	Get(string) interface{}
+}
+
+// expandS3ObjectCopySourceHeadObjectInput returns a HeadObject request for the copy source
+// and a client for the copy source's Region, which may differ from the destination's.
+func expandS3ObjectCopySourceHeadObjectInput(conn *s3.S3, d s3ObjectCopyResourceData) (*s3.S3, *s3.HeadObjectInput, error) {
+	source := d.Get("source").(string)
+
+	bucket, key, err := parseS3ObjectCopySource(source)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v, ok := d.Get("expected_source_bucket_owner").(string); ok && v != "" {
+		input.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := d.Get("request_payer").(string); ok && v != "" {
+		input.RequestPayer = aws.String(v)
+	}
+
+	if v, ok := d.Get("source_customer_algorithm").(string); ok && v != "" {
+		input.SSECustomerAlgorithm = aws.String(v)
+	}
+
+	if v, ok := d.Get("source_customer_key").(string); ok && v != "" {
+		input.SSECustomerKey = aws.String(v)
+	}
+
+	if v, ok := d.Get("source_customer_key_md5").(string); ok && v != "" {
+		input.SSECustomerKeyMD5 = aws.String(v)
+	}
+
+	sourceConn, err := s3ObjectCopySourceConn(conn, bucket)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error determining S3 object copy source (%s) Region: %w", source, err)
+	}
+
+	return sourceConn, input, nil
+}
+
+// parseS3ObjectCopySource splits a copy source of the form "bucket/key".
+// An access point ARN of the form "arn:...:accesspoint/name/object/key" is also accepted.
+func parseS3ObjectCopySource(source string) (string, string, error) {
+	source = strings.TrimPrefix(source, "/")
+
+	if arn.IsARN(source) {
+		if i := strings.Index(source, "/object/"); i > 0 {
+			return source[:i], source[i+len("/object/"):], nil
+		}
+	} else if i := strings.Index(source, "/"); i > 0 && i < len(source)-1 {
+		return source[:i], source[i+1:], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for S3 object copy source (%s), expected bucket/key", source)
+}
+
+func s3ObjectCopySourceConn(conn *s3.S3, bucket string) (*s3.S3, error) {
+	// Custom endpoints, e.g. S3-compatible servers, serve every bucket.
+	if aws.StringValue(conn.Config.Endpoint) != "" {
+		return conn, nil
+	}
+
+	var region string
+
+	if v, err := arn.Parse(bucket); err == nil {
+		region = v.Region
+	} else {
+		region, err = s3manager.GetBucketRegionWithClient(context.Background(), conn, bucket, func(r *request.Request) {
+			// See bucketLocation for why these settings are passed through.
+			r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+			r.Config.Credentials = conn.Config.Credentials
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if region == "" || region == aws.StringValue(conn.Config.Region) {
+		return conn, nil
+	}
+
+	sess, err := session.NewSession(&conn.Config)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS S3 session: %w", err)
+	}
+
+	return s3.New(sess.Copy(&aws.Config{Region: aws.String(region)})), nil
+}
+
+func abortS3MultipartUpload(conn *s3.S3, bucket, key, uploadID *string) {
+	_, err := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadID), err)
+	}
+}
+
 type s3Grants struct {
 	FullControl *string
 	Read        *string
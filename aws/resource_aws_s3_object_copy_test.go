@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"crypto/md5"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -39,6 +41,42 @@ func TestAccAWSS3ObjectCopy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3ObjectCopy_SourceChange(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_object_copy.test"
+	sourceName := "aws_s3_bucket_object.source"
+	key := "HundBegraven"
+	sourceKey := "WshngtnNtnls"
+	updatedContent := "Ingen ko på isen igen"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig_basic(rName1, sourceKey, rName2, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", sourceName, "etag"),
+					testAccCheckAWSS3ObjectCopyUpdateSource(sourceName, updatedContent),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSS3ObjectCopyConfig_basic(rName1, sourceKey, rName2, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etag", fmt.Sprintf("%x", md5.Sum([]byte(updatedContent)))),
+					resource.TestCheckResourceAttr(resourceName, "source_etag", fmt.Sprintf("%x", md5.Sum([]byte(updatedContent)))),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3ObjectCopy_BucketKeyEnabled_Bucket(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_s3_object_copy.test"
@@ -128,6 +166,25 @@ func testAccCheckAWSS3ObjectCopyExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAWSS3ObjectCopyUpdateSource(n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := s3conn.PutObject(
+			&s3.PutObjectInput{
+				Body:   strings.NewReader(content),
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(rs.Primary.Attributes["key"]),
+			})
+
+		return err
+	}
+}
+
 func testAccAWSS3ObjectCopyConfig_basic(rName1, sourceKey, rName2, key string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "source" {
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogAppRegistryApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsServiceCatalogAppRegistryApplicationCreate,
+		ReadContext:   resourceAwsServiceCatalogAppRegistryApplicationRead,
+		UpdateContext: resourceAwsServiceCatalogAppRegistryApplicationUpdate,
+		DeleteContext: resourceAwsServiceCatalogAppRegistryApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[-.\w]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsServiceCatalogAppRegistryApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appregistry.CreateApplicationInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppregistryTags()
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Application: %s", input)
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Service Catalog AppRegistry Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Application.Id))
+
+	return resourceAwsServiceCatalogAppRegistryApplicationRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	application, err := finder.ApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", application.Arn)
+	d.Set("description", application.Description)
+	d.Set("name", application.Name)
+
+	tags := keyvaluetags.AppregistryKeyValueTags(application.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogAppRegistryApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	if d.HasChanges("description", "name") {
+		input := &appregistry.UpdateApplicationInput{
+			Application: aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog AppRegistry Application: %s", input)
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppregistryUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Service Catalog AppRegistry Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogAppRegistryApplicationRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &appregistry.DeleteApplicationInput{
+		Application: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Service Catalog AppRegistry Application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfjson "github.com/terraform-providers/terraform-provider-aws/aws/internal/json"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsServiceCatalogAppRegistryAttributeGroupCreate,
+		ReadContext:   resourceAwsServiceCatalogAppRegistryAttributeGroupRead,
+		UpdateContext: resourceAwsServiceCatalogAppRegistryAttributeGroupUpdate,
+		DeleteContext: resourceAwsServiceCatalogAppRegistryAttributeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringLenBetween(1, 8000), validation.StringIsJSON),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return tfjson.StringsEquivalent(old, new)
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[-.\w]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	json, err := structure.NormalizeJsonString(d.Get("attributes").(string))
+
+	if err != nil {
+		return diag.Errorf("attributes (%s) is invalid JSON: %s", d.Get("attributes").(string), err)
+	}
+
+	name := d.Get("name").(string)
+	input := &appregistry.CreateAttributeGroupInput{
+		Attributes:  aws.String(json),
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().AppregistryTags()
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group: %s", input)
+	output, err := conn.CreateAttributeGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Service Catalog AppRegistry Attribute Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AttributeGroup.Id))
+
+	return resourceAwsServiceCatalogAppRegistryAttributeGroupRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	attributeGroup, err := finder.AttributeGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", attributeGroup.Arn)
+	d.Set("attributes", attributeGroup.Attributes)
+	d.Set("description", attributeGroup.Description)
+	d.Set("name", attributeGroup.Name)
+
+	tags := keyvaluetags.AppregistryKeyValueTags(attributeGroup.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	if d.HasChanges("attributes", "description", "name") {
+		input := &appregistry.UpdateAttributeGroupInput{
+			AttributeGroup: aws.String(d.Id()),
+			Description:    aws.String(d.Get("description").(string)),
+		}
+
+		if d.HasChange("attributes") {
+			json, err := structure.NormalizeJsonString(d.Get("attributes").(string))
+
+			if err != nil {
+				return diag.Errorf("attributes (%s) is invalid JSON: %s", d.Get("attributes").(string), err)
+			}
+
+			input.Attributes = aws.String(json)
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog AppRegistry Attribute Group: %s", input)
+		_, err := conn.UpdateAttributeGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AppregistryUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Service Catalog AppRegistry Attribute Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogAppRegistryAttributeGroupRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Attribute Group: %s", d.Id())
+	_, err := conn.DeleteAttributeGroupWithContext(ctx, &appregistry.DeleteAttributeGroupInput{
+		AttributeGroup: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
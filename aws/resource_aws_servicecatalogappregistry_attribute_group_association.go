@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfappregistry "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationCreate,
+		ReadContext:   resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationRead,
+		DeleteContext: resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attribute_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID := d.Get("application_id").(string)
+	attributeGroupID := d.Get("attribute_group_id").(string)
+	id := tfappregistry.AttributeGroupAssociationCreateID(applicationID, attributeGroupID)
+	input := &appregistry.AssociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group Association: %s", input)
+	_, err := conn.AssociateAttributeGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Service Catalog AppRegistry Attribute Group Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID, attributeGroupID, err := tfappregistry.AttributeGroupAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = finder.AttributeGroupAssociationByID(ctx, conn, applicationID, attributeGroupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Attribute Group Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("attribute_group_id", attributeGroupID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogAppRegistryAttributeGroupAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID, attributeGroupID, err := tfappregistry.AttributeGroupAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Attribute Group Association: %s", d.Id())
+	_, err = conn.DisassociateAttributeGroupWithContext(ctx, &appregistry.DisassociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Service Catalog AppRegistry Attribute Group Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappregistry "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogAppRegistryAttributeGroupAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_servicecatalogappregistry_application.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "attribute_group_id", "aws_servicecatalogappregistry_attribute_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogAppRegistryAttributeGroupAssociation_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogAppRegistryAttributeGroupAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_attribute_group_association" {
+			continue
+		}
+
+		applicationID, attributeGroupID, err := tfappregistry.AttributeGroupAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		err = finder.AttributeGroupAssociationByID(context.Background(), conn, applicationID, attributeGroupID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Attribute Group Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryAttributeGroupAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Attribute Group Association ID is set")
+		}
+
+		applicationID, attributeGroupID, err := tfappregistry.AttributeGroupAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+		return finder.AttributeGroupAssociationByID(context.Background(), conn, applicationID, attributeGroupID)
+	}
+}
+
+func testAccAWSServiceCatalogAppRegistryAttributeGroupAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    app = "exampleapp"
+  })
+}
+
+resource "aws_servicecatalogappregistry_attribute_group_association" "test" {
+  application_id     = aws_servicecatalogappregistry_application.test.id
+  attribute_group_id = aws_servicecatalogappregistry_attribute_group.test.id
+}
+`, rName)
+}
@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_servicecatalogappregistry_attribute_group", &resource.Sweeper{
+		Name: "aws_servicecatalogappregistry_attribute_group",
+		F:    testSweepServiceCatalogAppRegistryAttributeGroups,
+	})
+}
+
+func testSweepServiceCatalogAppRegistryAttributeGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*AWSClient).appregistryconn
+	sweepResources := make([]*testSweepResource, 0)
+
+	err = conn.ListAttributeGroupsPages(&appregistry.ListAttributeGroupsInput{}, func(page *appregistry.ListAttributeGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, attributeGroup := range page.AttributeGroups {
+			r := resourceAwsServiceCatalogAppRegistryAttributeGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(attributeGroup.Id))
+
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Service Catalog AppRegistry Attribute Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Service Catalog AppRegistry Attribute Groups (%s): %w", region, err)
+	}
+
+	err = testSweepResourceOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Service Catalog AppRegistry Attribute Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func TestAccAWSServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfig(rName, "description1", "group1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`/attribute-groups/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"app":"exampleapp","group":"group1"}`),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfig(rNameUpdated, "description2", "group2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"app":"exampleapp","group":"group2"}`),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogAppRegistryAttributeGroup_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfig(rName, "description1", "group1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogAppRegistryAttributeGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogAppRegistryAttributeGroup_Tags(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogAppRegistryAttributeGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryAttributeGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_attribute_group" {
+			continue
+		}
+
+		_, err := finder.AttributeGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Attribute Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryAttributeGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Attribute Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+		_, err := finder.AttributeGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSServiceCatalogAppRegistryAttributeGroupConfig(rName, description, group string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  attributes = jsonencode({
+    app   = "exampleapp"
+    group = %[3]q
+  })
+}
+`, rName, description, group)
+}
+
+func testAccAWSServiceCatalogAppRegistryAttributeGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    app = "exampleapp"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSServiceCatalogAppRegistryAttributeGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    app = "exampleapp"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfappregistry "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsServiceCatalogAppRegistryResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsServiceCatalogAppRegistryResourceAssociationCreate,
+		ReadContext:   resourceAwsServiceCatalogAppRegistryResourceAssociationRead,
+		DeleteContext: resourceAwsServiceCatalogAppRegistryResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appregistry.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogAppRegistryResourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID := d.Get("application_id").(string)
+	resourceName := d.Get("resource").(string)
+	resourceType := d.Get("resource_type").(string)
+	id := tfappregistry.ResourceAssociationCreateID(applicationID, resourceType, resourceName)
+	input := &appregistry.AssociateResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: aws.String(resourceType),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Resource Association: %s", input)
+	_, err := conn.AssociateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Service Catalog AppRegistry Resource Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsServiceCatalogAppRegistryResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceAwsServiceCatalogAppRegistryResourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID, resourceType, resourceName, err := tfappregistry.ResourceAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := finder.ResourceAssociationByID(ctx, conn, applicationID, resourceName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Service Catalog AppRegistry Resource Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("resource", resourceName)
+	d.Set("resource_arn", output.Arn)
+	d.Set("resource_type", resourceType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogAppRegistryResourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appregistryconn
+
+	applicationID, resourceType, resourceName, err := tfappregistry.ResourceAssociationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Resource Association: %s", d.Id())
+	_, err = conn.DisassociateResourceWithContext(ctx, &appregistry.DisassociateResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: aws.String(resourceType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Service Catalog AppRegistry Resource Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappregistry "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appregistry/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSServiceCatalogAppRegistryResourceAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_resource_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryResourceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryResourceAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_servicecatalogappregistry_application.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource", "aws_cloudformation_stack.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_cloudformation_stack.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", appregistry.ResourceTypeCfnStack),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogAppRegistryResourceAssociation_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_resource_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, appregistry.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogAppRegistryResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogAppRegistryResourceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogAppRegistryResourceAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogAppRegistryResourceAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryResourceAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_resource_association" {
+			continue
+		}
+
+		applicationID, _, resourceName, err := tfappregistry.ResourceAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.ResourceAssociationByID(context.Background(), conn, applicationID, resourceName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Resource Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogAppRegistryResourceAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Resource Association ID is set")
+		}
+
+		applicationID, _, resourceName, err := tfappregistry.ResourceAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).appregistryconn
+
+		_, err = finder.ResourceAssociationByID(context.Background(), conn, applicationID, resourceName)
+
+		return err
+	}
+}
+
+func testAccAWSServiceCatalogAppRegistryResourceAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      WaitHandle = {
+        Type = "AWS::CloudFormation::WaitConditionHandle"
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalogappregistry_resource_association" "test" {
+  application_id = aws_servicecatalogappregistry_application.test.id
+  resource       = aws_cloudformation_stack.test.name
+  resource_type  = "CFN_STACK"
+}
+`, rName)
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmcontacts/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsSsmContactsContactChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsSsmContactsContactChannelCreate,
+		ReadContext:   resourceAwsSsmContactsContactChannelRead,
+		UpdateContext: resourceAwsSsmContactsContactChannelUpdate,
+		DeleteContext: resourceAwsSsmContactsContactChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 320),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsSsmContactsContactChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateContactChannelInput{
+		ContactId:        aws.String(d.Get("contact_id").(string)),
+		DeliveryAddress:  expandSsmContactsContactChannelAddress(d.Get("delivery_address").([]interface{})),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Name:             aws.String(name),
+		Type:             aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Contact Channel: %s", input)
+	output, err := conn.CreateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Contacts Contact Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactChannelArn))
+
+	return resourceAwsSsmContactsContactChannelRead(ctx, d, meta)
+}
+
+func resourceAwsSsmContactsContactChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	contactChannel, err := finder.ContactChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("activation_status", contactChannel.ActivationStatus)
+	d.Set("arn", contactChannel.ContactChannelArn)
+	d.Set("contact_id", contactChannel.ContactArn)
+	d.Set("name", contactChannel.Name)
+	d.Set("type", contactChannel.Type)
+
+	if err := d.Set("delivery_address", flattenSsmContactsContactChannelAddress(contactChannel.DeliveryAddress)); err != nil {
+		return diag.Errorf("error setting delivery_address: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmContactsContactChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	input := &ssmcontacts.UpdateContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("delivery_address") {
+		input.DeliveryAddress = expandSsmContactsContactChannelAddress(d.Get("delivery_address").([]interface{}))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating SSM Contacts Contact Channel: %s", input)
+	_, err := conn.UpdateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSsmContactsContactChannelRead(ctx, d, meta)
+}
+
+func resourceAwsSsmContactsContactChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Contact Channel: %s", d.Id())
+	_, err := conn.DeleteContactChannelWithContext(ctx, &ssmcontacts.DeleteContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSsmContactsContactChannelAddress(tfList []interface{}) *ssmcontacts.ContactChannelAddress {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ssmcontacts.ContactChannelAddress{}
+
+	if v, ok := tfMap["simple_address"].(string); ok && v != "" {
+		apiObject.SimpleAddress = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSsmContactsContactChannelAddress(apiObject *ssmcontacts.ContactChannelAddress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleAddress; v != nil {
+		tfMap["simple_address"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
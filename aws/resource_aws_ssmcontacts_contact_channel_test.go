@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmcontacts/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsSsmContactsContactChannel_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := testAccRandomDomainName()
+	address1 := testAccRandomEmailAddress(domain)
+	address2 := testAccRandomEmailAddress(domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsContactChannelConfig(rName, rName, address1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", ssmcontacts.ActivationStatusNotActivated),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(fmt.Sprintf(`contact-channel/%s/.+`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", ssmcontacts.ChannelTypeEmail),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmContactsContactChannelConfig(rName, "updated", address2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address2),
+					resource.TestCheckResourceAttr(resourceName, "name", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSsmContactsContactChannel_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	address := testAccRandomEmailAddress(testAccRandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsContactChannelConfig(rName, rName, address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactChannelExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmContactsContactChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSsmContactsContactChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact_channel" {
+			continue
+		}
+
+		_, err := finder.ContactChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSsmContactsContactChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+		_, err := finder.ContactChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSsmContactsContactChannelConfig(rName, name, address string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id = aws_ssmcontacts_contact.test.arn
+  name       = %[2]q
+  type       = "EMAIL"
+
+  delivery_address {
+    simple_address = %[3]q
+  }
+}
+`, rName, name, address))
+}
@@ -0,0 +1,207 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmcontacts/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsSsmContactsContact_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsContactConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", fmt.Sprintf("contact/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ssmcontacts.ContactTypePersonal),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmContactsContactConfig(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSsmContactsContact_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsContactConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmContactsContact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSsmContactsContact_Tags(t *testing.T) {
+	resourceName := "aws_ssmcontacts_contact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsContactConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmContactsContactConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsSsmContactsContactConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSsmContactsContactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact" {
+			continue
+		}
+
+		_, err := finder.ContactByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSsmContactsContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+		_, err := finder.ContactByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSsmContactsContactConfig(rName, displayName string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias        = %[1]q
+  display_name = %[2]q
+  type         = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, displayName))
+}
+
+func testAccAwsSsmContactsContactConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAwsSsmContactsContactConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
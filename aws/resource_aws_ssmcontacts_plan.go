@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmcontacts/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsSsmContactsPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsSsmContactsPlanPut,
+		ReadContext:   resourceAwsSsmContactsPlanRead,
+		UpdateContext: resourceAwsSsmContactsPlanPut,
+		DeleteContext: resourceAwsSsmContactsPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+												"retry_interval_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 60),
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateArn,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSsmContactsPlanPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	contactID := d.Get("contact_id").(string)
+	input := &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(contactID),
+		Plan: &ssmcontacts.Plan{
+			Stages: expandSsmContactsStages(d.Get("stage").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting SSM Contacts Plan: %s", input)
+	_, err := conn.UpdateContactWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting SSM Contacts Plan (%s): %s", contactID, err)
+	}
+
+	d.SetId(contactID)
+
+	return resourceAwsSsmContactsPlanRead(ctx, d, meta)
+}
+
+func resourceAwsSsmContactsPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	contact, err := finder.ContactByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("contact_id", contact.ContactArn)
+
+	if contact.Plan != nil {
+		if err := d.Set("stage", flattenSsmContactsStages(contact.Plan.Stages)); err != nil {
+			return diag.Errorf("error setting stage: %s", err)
+		}
+	} else {
+		d.Set("stage", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmContactsPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmcontactsconn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Plan: %s", d.Id())
+	_, err := conn.UpdateContactWithContext(ctx, &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(d.Id()),
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSsmContactsStage(tfMap map[string]interface{}) *ssmcontacts.Stage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssmcontacts.Stage{
+		Targets: []*ssmcontacts.Target{},
+	}
+
+	if v, ok := tfMap["duration_in_minutes"].(int); ok {
+		apiObject.DurationInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["target"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Targets = expandSsmContactsTargets(v)
+	}
+
+	return apiObject
+}
+
+func expandSsmContactsStages(tfList []interface{}) []*ssmcontacts.Stage {
+	apiObjects := []*ssmcontacts.Stage{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandSsmContactsStage(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSsmContactsTarget(tfMap map[string]interface{}) *ssmcontacts.Target {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssmcontacts.Target{}
+
+	if v, ok := tfMap["channel_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		channelTargetInfo := &ssmcontacts.ChannelTargetInfo{}
+
+		if v, ok := tfMap["contact_channel_id"].(string); ok && v != "" {
+			channelTargetInfo.ContactChannelId = aws.String(v)
+		}
+
+		if v, ok := tfMap["retry_interval_in_minutes"].(int); ok && v != 0 {
+			channelTargetInfo.RetryIntervalInMinutes = aws.Int64(int64(v))
+		}
+
+		apiObject.ChannelTargetInfo = channelTargetInfo
+	}
+
+	if v, ok := tfMap["contact_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		contactTargetInfo := &ssmcontacts.ContactTargetInfo{}
+
+		if v, ok := tfMap["contact_id"].(string); ok && v != "" {
+			contactTargetInfo.ContactId = aws.String(v)
+		}
+
+		if v, ok := tfMap["is_essential"].(bool); ok {
+			contactTargetInfo.IsEssential = aws.Bool(v)
+		}
+
+		apiObject.ContactTargetInfo = contactTargetInfo
+	}
+
+	return apiObject
+}
+
+func expandSsmContactsTargets(tfList []interface{}) []*ssmcontacts.Target {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssmcontacts.Target
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandSsmContactsTarget(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSsmContactsStage(apiObject *ssmcontacts.Stage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DurationInMinutes; v != nil {
+		tfMap["duration_in_minutes"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Targets; v != nil {
+		tfMap["target"] = flattenSsmContactsTargets(v)
+	}
+
+	return tfMap
+}
+
+func flattenSsmContactsStages(apiObjects []*ssmcontacts.Stage) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenSsmContactsStage(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSsmContactsTarget(apiObject *ssmcontacts.Target) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ChannelTargetInfo; v != nil {
+		tfMap["channel_target_info"] = []interface{}{map[string]interface{}{
+			"contact_channel_id":        aws.StringValue(v.ContactChannelId),
+			"retry_interval_in_minutes": aws.Int64Value(v.RetryIntervalInMinutes),
+		}}
+	}
+
+	if v := apiObject.ContactTargetInfo; v != nil {
+		tfMap["contact_target_info"] = []interface{}{map[string]interface{}{
+			"contact_id":   aws.StringValue(v.ContactId),
+			"is_essential": aws.BoolValue(v.IsEssential),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenSsmContactsTargets(apiObjects []*ssmcontacts.Target) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenSsmContactsTarget(apiObject))
+	}
+
+	return tfList
+}
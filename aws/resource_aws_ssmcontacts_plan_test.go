@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmcontacts/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsSsmContactsPlan_basic(t *testing.T) {
+	resourceName := "aws_ssmcontacts_plan.test"
+	escalationResourceName := "aws_ssmcontacts_contact.escalation"
+	personalResourceName := "aws_ssmcontacts_contact.personal"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsPlanConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsPlanExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", escalationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.target.0.contact_target_info.0.contact_id", personalResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.0.is_essential", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmContactsPlanConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSsmContactsPlan_disappears(t *testing.T) {
+	resourceName := "aws_ssmcontacts_plan.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmContactsPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmContactsPlanConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmContactsPlanExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmContactsContact(), "aws_ssmcontacts_contact.escalation"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSsmContactsPlanDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_plan" {
+			continue
+		}
+
+		contact, err := finder.ContactByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if contact.Plan == nil || len(contact.Plan.Stages) == 0 {
+			continue
+		}
+
+		return fmt.Errorf("SSM Contacts Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSsmContactsPlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Plan ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmcontactsconn
+
+		contact, err := finder.ContactByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if contact.Plan == nil || len(contact.Plan.Stages) == 0 {
+			return fmt.Errorf("SSM Contacts Plan %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSsmContactsPlanConfig(rName string, durationInMinutes int) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "personal" {
+  alias = "%[1]s-personal"
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmcontacts_contact" "escalation" {
+  alias = "%[1]s-escalation"
+  type  = "ESCALATION"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmcontacts_plan" "test" {
+  contact_id = aws_ssmcontacts_contact.escalation.arn
+
+  stage {
+    duration_in_minutes = %[2]d
+
+    target {
+      contact_target_info {
+        contact_id   = aws_ssmcontacts_contact.personal.arn
+        is_essential = false
+      }
+    }
+  }
+}
+`, rName, durationInMinutes))
+}
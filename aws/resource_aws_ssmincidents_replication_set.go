@@ -0,0 +1,311 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmincidents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmincidents/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	ssmIncidentsReplicationSetRegionDefaultKmsKeyArn = "DefaultKey"
+)
+
+func resourceAwsSsmIncidentsReplicationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsSsmIncidentsReplicationSetCreate,
+		ReadContext:   resourceAwsSsmIncidentsReplicationSetRead,
+		UpdateContext: resourceAwsSsmIncidentsReplicationSetUpdate,
+		DeleteContext: resourceAwsSsmIncidentsReplicationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ReplicationSetCreatedTimeout),
+			Update: schema.DefaultTimeout(waiter.ReplicationSetUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.ReplicationSetDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ssmIncidentsReplicationSetRegionDefaultKmsKeyArn,
+							ValidateFunc: validation.Any(
+								validateArn,
+								validation.StringInSlice([]string{ssmIncidentsReplicationSetRegionDefaultKmsKeyArn}, false),
+							),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSsmIncidentsReplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	input := &ssmincidents.CreateReplicationSetInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Regions:     expandSsmIncidentsReplicationSetRegions(d.Get("region").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Incident Manager Replication Set: %s", input)
+	output, err := conn.CreateReplicationSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Incident Manager Replication Set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waiter.ReplicationSetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for SSM Incident Manager Replication Set (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAwsSsmIncidentsReplicationSetRead(ctx, d, meta)
+}
+
+func resourceAwsSsmIncidentsReplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	replicationSet, err := finder.ReplicationSetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incident Manager Replication Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incident Manager Replication Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", replicationSet.Arn)
+	d.Set("created_by", replicationSet.CreatedBy)
+	if replicationSet.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(replicationSet.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	d.Set("deletion_protected", replicationSet.DeletionProtected)
+	d.Set("last_modified_by", replicationSet.LastModifiedBy)
+	if replicationSet.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(replicationSet.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("status", replicationSet.Status)
+
+	if err := d.Set("region", flattenSsmIncidentsReplicationSetRegions(replicationSet.RegionMap)); err != nil {
+		return diag.Errorf("error setting region: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmIncidentsReplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	if d.HasChange("region") {
+		o, n := d.GetChange("region")
+		oldRegions := expandSsmIncidentsReplicationSetRegions(o.(*schema.Set).List())
+		newRegions := expandSsmIncidentsReplicationSetRegions(n.(*schema.Set).List())
+
+		var actions []*ssmincidents.UpdateReplicationSetAction
+
+		// Add new Regions first so that the Replication Set is never left without a Region.
+		for name, newRegion := range newRegions {
+			if _, ok := oldRegions[name]; ok {
+				continue
+			}
+
+			actions = append(actions, &ssmincidents.UpdateReplicationSetAction{
+				AddRegionAction: &ssmincidents.AddRegionAction{
+					RegionName:  aws.String(name),
+					SseKmsKeyId: newRegion.SseKmsKeyId,
+				},
+			})
+		}
+
+		// A Region's KMS key cannot be changed in place, so it is removed and re-added.
+		for name, oldRegion := range oldRegions {
+			newRegion, ok := newRegions[name]
+
+			if ok && aws.StringValue(newRegion.SseKmsKeyId) == aws.StringValue(oldRegion.SseKmsKeyId) {
+				continue
+			}
+
+			actions = append(actions, &ssmincidents.UpdateReplicationSetAction{
+				DeleteRegionAction: &ssmincidents.DeleteRegionAction{
+					RegionName: aws.String(name),
+				},
+			})
+
+			if ok {
+				actions = append(actions, &ssmincidents.UpdateReplicationSetAction{
+					AddRegionAction: &ssmincidents.AddRegionAction{
+						RegionName:  aws.String(name),
+						SseKmsKeyId: newRegion.SseKmsKeyId,
+					},
+				})
+			}
+		}
+
+		// Only one action can be performed at a time.
+		for _, action := range actions {
+			input := &ssmincidents.UpdateReplicationSetInput{
+				Actions:     []*ssmincidents.UpdateReplicationSetAction{action},
+				Arn:         aws.String(d.Id()),
+				ClientToken: aws.String(resource.UniqueId()),
+			}
+
+			log.Printf("[DEBUG] Updating SSM Incident Manager Replication Set: %s", input)
+			_, err := conn.UpdateReplicationSetWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error updating SSM Incident Manager Replication Set (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waiter.ReplicationSetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error waiting for SSM Incident Manager Replication Set (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsSsmIncidentsReplicationSetRead(ctx, d, meta)
+}
+
+func resourceAwsSsmIncidentsReplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	log.Printf("[DEBUG] Deleting SSM Incident Manager Replication Set: %s", d.Id())
+	_, err := conn.DeleteReplicationSetWithContext(ctx, &ssmincidents.DeleteReplicationSetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Incident Manager Replication Set (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waiter.ReplicationSetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for SSM Incident Manager Replication Set (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSsmIncidentsReplicationSetRegions(tfList []interface{}) map[string]*ssmincidents.RegionMapInputValue {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*ssmincidents.RegionMapInputValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, ok := tfMap["name"].(string)
+
+		if !ok || name == "" {
+			continue
+		}
+
+		apiObject := &ssmincidents.RegionMapInputValue{}
+
+		if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" && v != ssmIncidentsReplicationSetRegionDefaultKmsKeyArn {
+			apiObject.SseKmsKeyId = aws.String(v)
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenSsmIncidentsReplicationSetRegions(apiObjects map[string]*ssmincidents.RegionInfo) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": name,
+		}
+
+		if v := apiObject.SseKmsKeyId; v != nil {
+			tfMap["kms_key_arn"] = aws.StringValue(v)
+		} else {
+			tfMap["kms_key_arn"] = ssmIncidentsReplicationSetRegionDefaultKmsKeyArn
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
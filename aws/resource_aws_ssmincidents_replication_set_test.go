@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmincidents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsSsmIncidentsReplicationSet_basic(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsReplicationSetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsReplicationSetExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", regexp.MustCompile(`replication-set/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"kms_key_arn": "DefaultKey",
+						"name":        testAccGetRegion(),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", ssmincidents.ReplicationSetStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSsmIncidentsReplicationSet_disappears(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsReplicationSetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsReplicationSetExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmIncidentsReplicationSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSsmIncidentsReplicationSet_Region(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsReplicationSetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsSsmIncidentsReplicationSetConfigTwoRegions(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"name": testAccGetRegion(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"name": testAccGetAlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmIncidentsReplicationSetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSsmIncidentsReplicationSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmincidentsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmincidents_replication_set" {
+			continue
+		}
+
+		_, err := finder.ReplicationSetByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Incident Manager Replication Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSsmIncidentsReplicationSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Incident Manager Replication Set ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmincidentsconn
+
+		_, err := finder.ReplicationSetByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSsmIncidentsReplicationSetConfig() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+}
+`, testAccGetRegion())
+}
+
+func testAccAwsSsmIncidentsReplicationSetConfigTwoRegions() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+
+  region {
+    name = %[2]q
+  }
+}
+`, testAccGetRegion(), testAccGetAlternateRegion())
+}
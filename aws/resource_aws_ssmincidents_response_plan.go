@@ -0,0 +1,600 @@
+package aws
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmincidents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsSsmIncidentsResponsePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsSsmIncidentsResponsePlanCreate,
+		ReadContext:   resourceAwsSsmIncidentsResponsePlanRead,
+		UpdateContext: resourceAwsSsmIncidentsResponsePlanUpdate,
+		DeleteContext: resourceAwsSsmIncidentsResponsePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_automation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"document_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 128),
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(0, 10000),
+													},
+												},
+											},
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"target_account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ssmincidents.SsmTargetAccount_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"engagements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"incident_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedupe_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"impact": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"notification_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sns_topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"summary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 4000),
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsSsmIncidentsResponsePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssmincidents.CreateResponsePlanInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Actions = expandSsmIncidentsActions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("chat_channel"); ok && v.(*schema.Set).Len() > 0 {
+		input.ChatChannel = &ssmincidents.ChatChannel{
+			ChatbotSns: expandStringSet(v.(*schema.Set)),
+		}
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engagements"); ok && v.(*schema.Set).Len() > 0 {
+		input.Engagements = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("incident_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncidentTemplate = expandSsmIncidentsIncidentTemplate(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().SsmincidentsTags()
+	}
+
+	log.Printf("[DEBUG] Creating SSM Incident Manager Response Plan: %s", input)
+	output, err := conn.CreateResponsePlanWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Incident Manager Response Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceAwsSsmIncidentsResponsePlanRead(ctx, d, meta)
+}
+
+func resourceAwsSsmIncidentsResponsePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	responsePlan, err := finder.ResponsePlanByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incident Manager Response Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incident Manager Response Plan (%s): %s", d.Id(), err)
+	}
+
+	if len(responsePlan.Actions) > 0 {
+		if err := d.Set("action", []interface{}{flattenSsmIncidentsActions(responsePlan.Actions)}); err != nil {
+			return diag.Errorf("error setting action: %s", err)
+		}
+	} else {
+		d.Set("action", nil)
+	}
+
+	d.Set("arn", responsePlan.Arn)
+
+	if responsePlan.ChatChannel != nil {
+		d.Set("chat_channel", aws.StringValueSlice(responsePlan.ChatChannel.ChatbotSns))
+	} else {
+		d.Set("chat_channel", nil)
+	}
+
+	d.Set("display_name", responsePlan.DisplayName)
+	d.Set("engagements", aws.StringValueSlice(responsePlan.Engagements))
+
+	if responsePlan.IncidentTemplate != nil {
+		if err := d.Set("incident_template", []interface{}{flattenSsmIncidentsIncidentTemplate(responsePlan.IncidentTemplate)}); err != nil {
+			return diag.Errorf("error setting incident_template: %s", err)
+		}
+	} else {
+		d.Set("incident_template", nil)
+	}
+
+	d.Set("name", responsePlan.Name)
+
+	tags, err := keyvaluetags.SsmincidentsListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Incident Manager Response Plan (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmIncidentsResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssmincidents.UpdateResponsePlanInput{
+			Arn:         aws.String(d.Id()),
+			ClientToken: aws.String(resource.UniqueId()),
+		}
+
+		if d.HasChange("action") {
+			if v, ok := d.GetOk("action"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Actions = expandSsmIncidentsActions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.Actions = []*ssmincidents.Action{}
+			}
+		}
+
+		if d.HasChange("chat_channel") {
+			if v, ok := d.GetOk("chat_channel"); ok && v.(*schema.Set).Len() > 0 {
+				input.ChatChannel = &ssmincidents.ChatChannel{
+					ChatbotSns: expandStringSet(v.(*schema.Set)),
+				}
+			} else {
+				input.ChatChannel = &ssmincidents.ChatChannel{
+					Empty: &ssmincidents.EmptyChatChannel{},
+				}
+			}
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("engagements") {
+			input.Engagements = expandStringSet(d.Get("engagements").(*schema.Set))
+
+			if input.Engagements == nil {
+				input.Engagements = []*string{}
+			}
+		}
+
+		if d.HasChange("incident_template") {
+			if v, ok := d.GetOk("incident_template"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				incidentTemplate := expandSsmIncidentsIncidentTemplate(v.([]interface{})[0].(map[string]interface{}))
+
+				input.IncidentTemplateDedupeString = aws.String(aws.StringValue(incidentTemplate.DedupeString))
+				input.IncidentTemplateImpact = incidentTemplate.Impact
+				input.IncidentTemplateNotificationTargets = incidentTemplate.NotificationTargets
+				input.IncidentTemplateSummary = aws.String(aws.StringValue(incidentTemplate.Summary))
+				input.IncidentTemplateTitle = incidentTemplate.Title
+
+				if input.IncidentTemplateNotificationTargets == nil {
+					input.IncidentTemplateNotificationTargets = []*ssmincidents.NotificationTargetItem{}
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SSM Incident Manager Response Plan: %s", input)
+		_, err := conn.UpdateResponsePlanWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating SSM Incident Manager Response Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SsmincidentsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating SSM Incident Manager Response Plan (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSsmIncidentsResponsePlanRead(ctx, d, meta)
+}
+
+func resourceAwsSsmIncidentsResponsePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).ssmincidentsconn
+
+	log.Printf("[DEBUG] Deleting SSM Incident Manager Response Plan: %s", d.Id())
+	_, err := conn.DeleteResponsePlanWithContext(ctx, &ssmincidents.DeleteResponsePlanInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Incident Manager Response Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSsmIncidentsIncidentTemplate(tfMap map[string]interface{}) *ssmincidents.IncidentTemplate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssmincidents.IncidentTemplate{}
+
+	if v, ok := tfMap["dedupe_string"].(string); ok && v != "" {
+		apiObject.DedupeString = aws.String(v)
+	}
+
+	if v, ok := tfMap["impact"].(int); ok && v != 0 {
+		apiObject.Impact = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["notification_target"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NotificationTargets = expandSsmIncidentsNotificationTargetItems(v.List())
+	}
+
+	if v, ok := tfMap["summary"].(string); ok && v != "" {
+		apiObject.Summary = aws.String(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSsmIncidentsNotificationTargetItems(tfList []interface{}) []*ssmincidents.NotificationTargetItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssmincidents.NotificationTargetItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmincidents.NotificationTargetItem{}
+
+		if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+			apiObject.SnsTopicArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSsmIncidentsActions(tfMap map[string]interface{}) []*ssmincidents.Action {
+	if tfMap == nil {
+		return nil
+	}
+
+	var apiObjects []*ssmincidents.Action
+
+	if v, ok := tfMap["ssm_automation"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects = append(apiObjects, &ssmincidents.Action{
+				SsmAutomation: expandSsmIncidentsSsmAutomation(tfMap),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandSsmIncidentsSsmAutomation(tfMap map[string]interface{}) *ssmincidents.SsmAutomation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssmincidents.SsmAutomation{}
+
+	if v, ok := tfMap["document_name"].(string); ok && v != "" {
+		apiObject.DocumentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["document_version"].(string); ok && v != "" {
+		apiObject.DocumentVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+		parameters := make(map[string][]*string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			parameters[tfMap["name"].(string)] = expandStringSet(tfMap["values"].(*schema.Set))
+		}
+
+		apiObject.Parameters = parameters
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_account"].(string); ok && v != "" {
+		apiObject.TargetAccount = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSsmIncidentsIncidentTemplate(apiObject *ssmincidents.IncidentTemplate) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DedupeString; v != nil {
+		tfMap["dedupe_string"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Impact; v != nil {
+		tfMap["impact"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.NotificationTargets; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"sns_topic_arn": aws.StringValue(apiObject.SnsTopicArn),
+			})
+		}
+
+		tfMap["notification_target"] = tfList
+	}
+
+	if v := apiObject.Summary; v != nil {
+		tfMap["summary"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Title; v != nil {
+		tfMap["title"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSsmIncidentsActions(apiObjects []*ssmincidents.Action) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var ssmAutomations []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.SsmAutomation == nil {
+			continue
+		}
+
+		ssmAutomations = append(ssmAutomations, flattenSsmIncidentsSsmAutomation(apiObject.SsmAutomation))
+	}
+
+	return map[string]interface{}{
+		"ssm_automation": ssmAutomations,
+	}
+}
+
+func flattenSsmIncidentsSsmAutomation(apiObject *ssmincidents.SsmAutomation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DocumentName; v != nil {
+		tfMap["document_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DocumentVersion; v != nil {
+		tfMap["document_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		var tfList []interface{}
+
+		for name, values := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"name":   name,
+				"values": aws.StringValueSlice(values),
+			})
+		}
+
+		tfMap["parameter"] = tfList
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TargetAccount; v != nil {
+		tfMap["target_account"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssmincidents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func testAccAwsSsmIncidentsResponsePlan_basic(t *testing.T) {
+	resourceName := "aws_ssmincidents_response_plan.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", fmt.Sprintf("response-plan/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSsmIncidentsResponsePlan_disappears(t *testing.T) {
+	resourceName := "aws_ssmincidents_response_plan.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsmIncidentsResponsePlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsSsmIncidentsResponsePlan_Full(t *testing.T) {
+	resourceName := "aws_ssmincidents_response_plan.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfigFull(rName, "summary1", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.document_name", "AWSIncidents-CriticalIncidentRunbookTemplate"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.parameter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.ssm_automation.0.role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.target_account", ssmincidents.SsmTargetAccountResponsePlanOwnerAccount),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "chat_channel.*", snsTopicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.dedupe_string", rName),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "2"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "incident_template.0.notification_target.*.sns_topic_arn", snsTopicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", "summary1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfigFull(rName, "summary2", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "4"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", "summary2"),
+				),
+			},
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSsmIncidentsResponsePlan_Tags(t *testing.T) {
+	resourceName := "aws_ssmincidents_response_plan.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSsmIncidentsResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsSsmIncidentsResponsePlanConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSsmIncidentsResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSsmIncidentsResponsePlanDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmincidentsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmincidents_response_plan" {
+			continue
+		}
+
+		_, err := finder.ResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Incident Manager Response Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSsmIncidentsResponsePlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Incident Manager Response Plan ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmincidentsconn
+
+		_, err := finder.ResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsSsmIncidentsResponsePlanConfig(rName string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccAwsSsmIncidentsResponsePlanConfigFull(rName, summary string, impact int) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm-incidents.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name         = %[1]q
+  display_name = %[1]q
+  chat_channel = [aws_sns_topic.test.arn]
+
+  incident_template {
+    title         = %[1]q
+    impact        = %[3]d
+    summary       = %[2]q
+    dedupe_string = %[1]q
+
+    notification_target {
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  action {
+    ssm_automation {
+      document_name  = "AWSIncidents-CriticalIncidentRunbookTemplate"
+      role_arn       = aws_iam_role.test.arn
+      target_account = "RESPONSE_PLAN_OWNER_ACCOUNT"
+
+      parameter {
+        name   = "key"
+        values = ["value1", "value2"]
+      }
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, summary, impact))
+}
+
+func testAccAwsSsmIncidentsResponsePlanConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAwsSsmIncidentsResponsePlanConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAwsSsmIncidentsReplicationSetConfig(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"testing"
+)
+
+// The Incident Manager replication set is a per-account singleton which must
+// exist before response plans and contacts can be created, so all Incident
+// Manager and SSM Contacts acceptance tests run serially.
+func TestAccAWSSSMIncidentManager_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Contact": {
+			"basic":      testAccAwsSsmContactsContact_basic,
+			"disappears": testAccAwsSsmContactsContact_disappears,
+			"tags":       testAccAwsSsmContactsContact_Tags,
+		},
+		"ContactChannel": {
+			"basic":      testAccAwsSsmContactsContactChannel_basic,
+			"disappears": testAccAwsSsmContactsContactChannel_disappears,
+		},
+		"Plan": {
+			"basic":      testAccAwsSsmContactsPlan_basic,
+			"disappears": testAccAwsSsmContactsPlan_disappears,
+		},
+		"ReplicationSet": {
+			"basic":      testAccAwsSsmIncidentsReplicationSet_basic,
+			"disappears": testAccAwsSsmIncidentsReplicationSet_disappears,
+			"region":     testAccAwsSsmIncidentsReplicationSet_Region,
+		},
+		"ResponsePlan": {
+			"basic":      testAccAwsSsmIncidentsResponsePlan_basic,
+			"disappears": testAccAwsSsmIncidentsResponsePlan_disappears,
+			"full":       testAccAwsSsmIncidentsResponsePlan_Full,
+			"tags":       testAccAwsSsmIncidentsResponsePlan_Tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -99,18 +101,79 @@ func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t stri
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+//
+// Resource tags merged with those defined at the provider-level are also
+// validated against any provider-level required tags.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateRequiredTagsDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	return setTagsAllDiff(diff, meta, "tags", "tags_all")
+}
+
+// resourceTypeContextKey is the context key for the resource type being planned.
+type resourceTypeContextKey struct{}
+
+// resourceTypeCustomizeDiff wraps the CustomizeDiff function of a resource
+// so that the resource type is available to SetTagsDiff.
+func resourceTypeCustomizeDiff(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return customizeDiff(context.WithValue(ctx, resourceTypeContextKey{}, resourceType), diff, meta)
+	}
+}
+
+// validateRequiredTagsDiff validates planned resource tags, merged with those
+// defined at the provider-level, against the provider-level required tags.
+func validateRequiredTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*AWSClient).RequiredTagsConfig
+
+	resourceType, _ := ctx.Value(resourceTypeContextKey{}).(string)
+
+	if requiredTagsConfig.Excluded(resourceType) {
+		return nil
+	}
+
+	// Tags referencing values not yet known are validated once known.
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
 
 	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
 
+	err := requiredTagsConfig.Validate(defaultTagsConfig.MergeTags(resourceTags))
+
+	if err == nil {
+		return nil
+	}
+
+	if requiredTagsConfig.Enforcement == keyvaluetags.RequiredTagsEnforcementWarn {
+		log.Printf("[WARN] Resource type %s (%s): %s", resourceType, diff.Id(), err)
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", resourceType, err)
+}
+
+// setTagsAllDiff is SetTagsDiff for resources with tags in attributes other
+// than "tags" and "tags_all", such as EC2 Instance "volume_tags".
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}, tagsKey, tagsAllKey string) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resourceTags := keyvaluetags.New(diff.Get(tagsKey).(map[string]interface{}))
+
 	if defaultTagsConfig.TagsEqual(resourceTags) {
-		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
+		return fmt.Errorf(`%q are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`, tagsKey)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	return setTagsAllNewDiff(diff, defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig), tagsAllKey)
+}
 
+// setTagsAllNewDiff sets the new plan difference of the named attribute to the
+// merger of resource tags on to those defined at the provider-level.
+func setTagsAllNewDiff(diff *schema.ResourceDiff, allTags keyvaluetags.KeyValueTags, tagsAllKey string) error {
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -118,22 +181,45 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18366
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19005
 	if len(allTags) > 0 {
-		if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
-			return fmt.Errorf("error setting new tags_all diff: %w", err)
+		if err := diff.SetNew(tagsAllKey, allTags.Map()); err != nil {
+			return fmt.Errorf("error setting new %s diff: %w", tagsAllKey, err)
 		}
-	} else if len(diff.Get("tags_all").(map[string]interface{})) > 0 {
-		if err := diff.SetNewComputed("tags_all"); err != nil {
-			return fmt.Errorf("error setting tags_all to computed: %w", err)
+	} else if len(diff.Get(tagsAllKey).(map[string]interface{})) > 0 {
+		if err := diff.SetNewComputed(tagsAllKey); err != nil {
+			return fmt.Errorf("error setting %s to computed: %w", tagsAllKey, err)
 		}
-	} else if diff.HasChange("tags_all") {
-		if err := diff.SetNewComputed("tags_all"); err != nil {
-			return fmt.Errorf("error setting tags_all to computed: %w", err)
+	} else if diff.HasChange(tagsAllKey) {
+		if err := diff.SetNewComputed(tagsAllKey); err != nil {
+			return fmt.Errorf("error setting %s to computed: %w", tagsAllKey, err)
 		}
 	}
 
 	return nil
 }
 
+// untaggableResourceTypesWarned records the resource types for which the
+// default tags warning has already been logged.
+var untaggableResourceTypesWarned sync.Map
+
+// warnUntaggableResourceDiff wraps the CustomizeDiff function of a resource
+// that cannot accept tags so that a warning is logged, once per resource type,
+// when planning changes with provider-level default tags configured.
+func warnUntaggableResourceDiff(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*AWSClient); ok && len(client.DefaultTagsConfig.GetTags()) > 0 {
+			if _, warned := untaggableResourceTypesWarned.LoadOrStore(resourceType, true); !warned {
+				log.Printf("[WARN] Resource type %s does not support tags: provider default_tags are not applied", resourceType)
+			}
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, diff, meta)
+	}
+}
+
 // getInstanceTagValue returns instance tag value by name
 func getInstanceTagValue(conn *ec2.EC2, instanceId string, tagKey string) (*string, error) {
 	tagsResp, err := conn.DescribeTags(&ec2.DescribeTagsInput{
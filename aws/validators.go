@@ -2484,6 +2484,28 @@ func MapKeyInSlice(valid []string, ignoreCase bool) schema.SchemaValidateFunc {
 	}
 }
 
+// validateMemoryDbName validates the name of a MemoryDB resource.
+func validateMemoryDbName(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z-]+$`), "must contain only alphanumeric characters and hyphens"),
+		validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with a letter"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end with a hyphen"),
+	)
+}
+
+// validateMemoryDbNamePrefix validates the name prefix of a MemoryDB resource,
+// which is followed by a generated unique ID.
+func validateMemoryDbNamePrefix(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength-resource.UniqueIDSuffixLength),
+		validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z-]+$`), "must contain only alphanumeric characters and hyphens"),
+		validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with a letter"),
+		validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+	)
+}
+
 var validateTypeStringIsDateOrPositiveInt = validation.Any(
 	validation.IsRFC3339Time,
 	validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a positive integer value"),
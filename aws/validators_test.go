@@ -3345,3 +3345,35 @@ func TestResourceAWSEKSClusterNameValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateMemoryDbName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"Valid-Name-01",
+		"a" + strings.Repeat("x", 39),
+	}
+	for _, v := range validNames {
+		_, errors := validateMemoryDbName(40)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid MemoryDB name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"0invalid",
+		"-invalid",
+		"invalid-",
+		"in--valid",
+		"in_valid",
+		"in.valid",
+		// length > 40
+		"a" + strings.Repeat("x", 40),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateMemoryDbName(40)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid MemoryDB name", v)
+		}
+	}
+}
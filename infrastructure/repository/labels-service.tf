@@ -19,6 +19,7 @@ variable "service_labels" {
     "applicationdiscoveryservice",
     "applicationinsights",
     "appmesh",
+    "appregistry",
     "apprunner",
     "appstream",
     "appsync",
@@ -138,6 +139,7 @@ variable "service_labels" {
     "mediapackagevod",
     "mediastore",
     "mediatailor",
+    "memorydb",
     "meteringmarketplace",
     "mobile",
     "mq",